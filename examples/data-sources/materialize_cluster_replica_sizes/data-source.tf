@@ -0,0 +1,11 @@
+data "materialize_cluster_replica_sizes" "all" {}
+
+# Pick the smallest size with at least 2 workers
+locals {
+  two_worker_sizes = [for s in data.materialize_cluster_replica_sizes.all.sizes : s.size if s.workers >= 2]
+}
+
+resource "materialize_cluster" "example_cluster" {
+  name = "example_cluster"
+  size = local.two_worker_sizes[0]
+}
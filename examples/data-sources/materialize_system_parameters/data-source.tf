@@ -0,0 +1 @@
+data "materialize_system_parameters" "all" {}
\ No newline at end of file
@@ -1,3 +1,24 @@
 resource "materialize_cluster" "example_cluster" {
   name = "cluster"
-}
\ No newline at end of file
+}
+resource "materialize_cluster" "example_managed_cluster" {
+  name               = "managed_cluster"
+  size               = "3xsmall"
+  replication_factor = 2
+  availability_zones = ["use1-az1", "use1-az2"]
+}
+
+# CREATE CLUSTER managed_cluster SIZE '3xsmall', REPLICATION FACTOR 2, AVAILABILITY ZONES = ['use1-az1','use1-az2'];
+
+resource "materialize_cluster" "example_graceful_cluster" {
+  name            = "graceful_cluster"
+  size            = "3xsmall"
+  resize_strategy = "graceful"
+
+  timeouts {
+    update = "20m"
+  }
+}
+
+# Changing size issues:
+# ALTER CLUSTER graceful_cluster SET (SIZE = '2xsmall') WITH (WAIT UNTIL READY (TIMEOUT = '20m0s', ON TIMEOUT = 'ROLLBACK'));
@@ -0,0 +1,4 @@
+# Cluster swaps can be imported using the concatenation of CLUSTER SWAP, the cluster name and the name of the cluster it is swapped with
+terraform import materialize_cluster_swap.example_cluster_swap "<region>:CLUSTER SWAP|<cluster_name>|<swap_with>"
+
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,14 @@
+resource "materialize_cluster_swap" "example_cluster_swap" {
+  cluster_name = "compute"
+  swap_with    = "compute_green"
+
+  # Change to cut over, once the green cluster is hydrated
+  trigger          = "release-42"
+  wait_until_ready = true
+
+  timeouts {
+    update = "30m"
+  }
+}
+
+# ALTER CLUSTER "compute" SWAP WITH "compute_green";
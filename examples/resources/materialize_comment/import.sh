@@ -0,0 +1,8 @@
+# Comments can be imported using the concatenation of COMMENT, the object type and the id of the object
+terraform import materialize_comment.subsource_comment <region>:COMMENT|source|<object_id>
+
+# Column comments also include the column name
+terraform import materialize_comment.subsource_column_comment <region>:COMMENT|source|<object_id>|<column_name>
+
+# Comments can be found in the `mz_internal.mz_comments` table
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,19 @@
+resource "materialize_comment" "subsource_comment" {
+  object_type   = "SOURCE"
+  object_name   = "bids"
+  schema_name   = "schema"
+  database_name = "database"
+  comment       = "Bids ingested by the auction load generator"
+}
+
+resource "materialize_comment" "subsource_column_comment" {
+  object_type   = "SOURCE"
+  object_name   = "bids"
+  schema_name   = "schema"
+  database_name = "database"
+  column_name   = "amount"
+  comment       = "Bid amount in cents"
+}
+
+# COMMENT ON SOURCE "database"."schema"."bids" IS 'Bids ingested by the auction load generator';
+# COMMENT ON COLUMN "database"."schema"."bids"."amount" IS 'Bid amount in cents';
@@ -12,3 +12,32 @@ resource "materialize_connection_aws_privatelink" "example_privatelink_connectio
 #     SERVICE NAME 'com.amazonaws.us-east-1.materialize.example',
 #     AVAILABILITY ZONES ('use1-az2', 'use1-az6')
 # );
+
+# Allow the Materialize principal on the endpoint service and wait for the
+# link to be available before creating connections that use it
+resource "aws_vpc_endpoint_service_allowed_principal" "example_privatelink_connection" {
+  vpc_endpoint_service_id = "vpce-svc-0123456789abcdef0"
+  principal_arn           = materialize_connection_aws_privatelink.example_privatelink_available.principal
+}
+
+resource "materialize_connection_aws_privatelink" "example_privatelink_available" {
+  name               = "example_privatelink_available"
+  schema_name        = "public"
+  service_name       = "com.amazonaws.us-east-1.materialize.example"
+  availability_zones = ["use1-az2", "use1-az6"]
+  wait_for_available = true
+
+  timeouts {
+    create = "20m"
+  }
+}
+
+resource "materialize_connection_kafka" "example_kafka_privatelink" {
+  name = "example_kafka_privatelink"
+  kafka_broker {
+    broker = "b-1.hostname-1:9096"
+    privatelink_connection {
+      name = materialize_connection_aws_privatelink.example_privatelink_available.name
+    }
+  }
+}
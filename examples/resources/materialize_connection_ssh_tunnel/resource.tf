@@ -12,3 +12,22 @@ resource "materialize_connection_ssh_tunnel" "example_ssh_connection" {
 #    PORT 22,
 #    USER 'example'
 # );
+
+# Rotate the key pairs by changing rotation_trigger
+resource "materialize_connection_ssh_tunnel" "example_ssh_connection_rotated" {
+  name             = "ssh_example_connection_rotated"
+  schema_name      = "public"
+  host             = "example.com"
+  port             = 22
+  user             = "example"
+  rotation_trigger = "2024-01-01"
+}
+
+# ALTER CONNECTION ssh_example_connection_rotated ROTATE KEYS;
+
+output "ssh_public_keys" {
+  value = [
+    materialize_connection_ssh_tunnel.example_ssh_connection_rotated.public_key_1,
+    materialize_connection_ssh_tunnel.example_ssh_connection_rotated.public_key_2,
+  ]
+}
@@ -13,4 +13,15 @@ resource "materialize_index" "loadgen_index" {
 # CREATE INDEX index
 #     IN CLUSTER cluster
 #     ON "database"."schema"."source"
-#     USING ARRANGEMENT
\ No newline at end of file
+#     USING ARRANGEMENT
+resource "materialize_index" "hydrated_index" {
+  name             = "hydrated_index"
+  cluster_name     = "cluster"
+  wait_until_ready = true
+
+  obj_name {
+    name          = "source"
+    schema_name   = "schema"
+    database_name = "database"
+  }
+}
@@ -20,3 +20,27 @@ resource "materialize_materialized_view" "simple_materialized_view" {
 
   statement = "SELECT * FROM materialize.public.simple_table"
 }
+
+resource "materialize_materialized_view" "hydrated_materialized_view" {
+  name             = "hydrated_materialized_view"
+  cluster_name     = "cluster"
+  statement        = "SELECT * FROM materialize.public.simple_table"
+  wait_until_ready = true
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+# Changes to the statement build a shadow materialized view and swap it in
+# once hydrated, moving any sinks reading from it
+resource "materialize_materialized_view" "swapped_materialized_view" {
+  name                 = "swapped_materialized_view"
+  cluster_name         = "cluster"
+  statement            = "SELECT * FROM materialize.public.simple_table"
+  replacement_strategy = "create_then_swap"
+
+  timeouts {
+    update = "30m"
+  }
+}
@@ -0,0 +1,5 @@
+# Role parameters can be imported using the concatenation of ROLE PARAMETER, the id of the role and the variable name
+terraform import materialize_role_parameter.example_role_cluster <region>:ROLE PARAMETER|<role_id>|<variable_name>
+
+# Role parameters can be found in the `mz_catalog.mz_role_parameters` table
+# The region is the region where the database is located (e.g. aws/us-east-1)
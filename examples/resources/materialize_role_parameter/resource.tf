@@ -0,0 +1,5 @@
+resource "materialize_role_parameter" "example_role_cluster" {
+  role_name      = "example_role"
+  variable_name  = "cluster"
+  variable_value = "quickstart"
+}
@@ -0,0 +1,4 @@
+# Schema swaps can be imported using the concatenation of SCHEMA SWAP, the database name, the schema name and the name of the schema it is swapped with
+terraform import materialize_schema_swap.example_schema_swap "<region>:SCHEMA SWAP|<database_name>|<schema_name>|<swap_with>"
+
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,15 @@
+resource "materialize_schema_swap" "example_schema_swap" {
+  schema_name   = "analytics"
+  swap_with     = "analytics_green"
+  database_name = "database"
+
+  # Change to cut over, once the green schema is hydrated
+  trigger          = "release-42"
+  wait_until_ready = true
+
+  timeouts {
+    update = "30m"
+  }
+}
+
+# ALTER SCHEMA "database"."analytics" SWAP WITH "analytics_green";
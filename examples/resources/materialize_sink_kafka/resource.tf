@@ -29,3 +29,67 @@ resource "materialize_sink_kafka" "example_sink_kafka" {
 #   FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
 #   ENVELOPE UPSERT
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_sink_kafka" "example_sink_kafka_topic_options" {
+  name        = "sink_kafka_topic_options"
+  schema_name = "schema"
+  size        = "3xsmall"
+  from {
+    name = "table"
+  }
+  topic                    = "test_json_topic"
+  compression_type         = "lz4"
+  partition_by             = "seahash(id)"
+  topic_partition_count    = 6
+  topic_replication_factor = 3
+  topic_config = {
+    "cleanup.policy" = "compact"
+  }
+  key     = ["id"]
+  headers = "headers"
+  key_format {
+    text = true
+  }
+  value_format {
+    json = true
+  }
+  kafka_connection {
+    name = "kafka_connection"
+  }
+  envelope {
+    upsert = true
+  }
+}
+
+# CREATE SINK schema.sink_kafka_topic_options
+#   FROM schema.table
+#   INTO KAFKA CONNECTION "kafka_connection" (TOPIC 'test_json_topic', COMPRESSION TYPE = 'lz4',
+#     PARTITION BY = seahash(id), TOPIC CONFIG MAP['cleanup.policy' => 'compact'],
+#     TOPIC PARTITION COUNT = 6, TOPIC REPLICATION FACTOR = 3)
+#   KEY (id) HEADERS "headers"
+#   KEY FORMAT TEXT VALUE FORMAT JSON
+#   ENVELOPE UPSERT
+#   WITH (SIZE = '3xsmall');
+
+resource "materialize_sink_kafka" "example_sink_kafka_wait" {
+  name        = "sink_kafka_wait"
+  schema_name = "schema"
+  topic       = "test_wait_topic"
+
+  # Fail the apply with the sink error if it stalls, for example when the
+  # topic cannot be created
+  wait_for_running = true
+
+  from {
+    name = "table"
+  }
+  kafka_connection {
+    name = "kafka_connection"
+  }
+  format {
+    json = true
+  }
+  envelope {
+    debezium = true
+  }
+}
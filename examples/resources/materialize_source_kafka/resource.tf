@@ -26,3 +26,40 @@ resource "materialize_source_kafka" "example_source_kafka" {
 #   FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
 #   ENVELOPE NONE
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_source_kafka" "example_source_kafka_upsert" {
+  name                            = "source_kafka_upsert"
+  schema_name                     = "schema"
+  size                            = "3xsmall"
+  topic                           = "data"
+  group_id_prefix                 = "materialize_upsert"
+  topic_metadata_refresh_interval = "30s"
+  kafka_connection {
+    name          = "kafka_connection"
+    database_name = "database"
+    schema_name   = "schema"
+  }
+  key_format {
+    text = true
+  }
+  value_format {
+    text = true
+  }
+  envelope {
+    upsert = true
+    upsert_options {
+      value_decoding_errors {
+        inline {
+          enabled = true
+          alias   = "decoding_error"
+        }
+      }
+    }
+  }
+}
+
+# CREATE SOURCE source_kafka_upsert
+#   FROM KAFKA CONNECTION "database"."schema"."kafka_connection" (TOPIC 'data', GROUP ID PREFIX 'materialize_upsert', TOPIC METADATA REFRESH INTERVAL '30s')
+#   KEY FORMAT TEXT VALUE FORMAT TEXT
+#   ENVELOPE UPSERT (VALUE DECODING ERRORS = (INLINE AS "decoding_error"))
+#   WITH (SIZE = '3xsmall');
@@ -15,3 +15,27 @@ resource "materialize_source_load_generator" "example_source_load_generator" {
 #   FROM LOAD GENERATOR COUNTER
 #   (TICK INTERVAL '500ms' SCALE FACTOR 0.01)
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_source_load_generator" "example_source_load_generator_key_value" {
+  name        = "source_load_generator_key_value"
+  schema_name = "schema"
+  size        = "3xsmall"
+
+  load_generator_type = "KEY VALUE"
+
+  key_value_options {
+    keys            = 128
+    snapshot_rounds = 2
+    value_size      = 64
+    seed            = 42
+    partitions      = 4
+    batch_size      = 8
+  }
+
+  up_to = 1000
+}
+
+# CREATE SOURCE schema.source_load_generator_key_value
+#   FROM LOAD GENERATOR KEY VALUE
+#   (KEYS 128, SNAPSHOT ROUNDS 2, TRANSACTIONAL SNAPSHOT true, VALUE SIZE 64, SEED 42, PARTITIONS 4, BATCH SIZE 8, UP TO 1000)
+#   WITH (SIZE = '3xsmall');
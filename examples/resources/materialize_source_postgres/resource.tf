@@ -12,17 +12,38 @@ resource "materialize_source_postgres" "example_source_postgres" {
   }
 
   table {
-    name  = "schema1.table_1"
-    alias = "s1_table_1"
+    name         = "schema1.table_1"
+    alias        = "s1_table_1"
+    text_columns = ["unsupported_type"]
   }
 
   table {
-    name  = "schema2.table_1"
-    alias = "s2_table_1"
+    name            = "schema2.table_1"
+    alias           = "s2_table_1"
+    exclude_columns = ["internal_notes"]
   }
 }
 
 # CREATE SOURCE schema.source_postgres
-#   FROM POSTGRES CONNECTION "database"."schema"."pg_connection" (PUBLICATION 'mz_source')
+#   FROM POSTGRES CONNECTION "database"."schema"."pg_connection" (PUBLICATION 'mz_source', TEXT COLUMNS (schema1.table_1.unsupported_type), EXCLUDE COLUMNS (schema2.table_1.internal_notes))
 #   FOR TABLES (schema1.table_1 AS s1_table_1, schema2_table_1 AS s2_table_1)
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_source_postgres" "example_source_postgres_wait" {
+  name        = "source_postgres_wait"
+  schema_name = "schema"
+  size        = "3xsmall"
+  publication = "mz_source"
+
+  # Fail the apply with the upstream error if the source stalls, and only
+  # complete once the initial snapshot of the tables is committed
+  wait_for_snapshot = true
+
+  postgres_connection {
+    name = "pg_connection"
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
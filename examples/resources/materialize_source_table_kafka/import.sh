@@ -0,0 +1,5 @@
+# Source tables can be imported using the table id:
+terraform import materialize_source_table_kafka.example_source_table_kafka <region>:<table_id>
+
+# Table id and information be found in the `mz_catalog.mz_tables` table
+# The region is the region where the database is located (e.g. aws/us-east-1)
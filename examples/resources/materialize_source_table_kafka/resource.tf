@@ -0,0 +1,30 @@
+resource "materialize_source_table_kafka" "example_source_table_kafka" {
+  name        = "events"
+  schema_name = "schema"
+
+  source {
+    name = "source_kafka"
+    # Optional parameters
+    # database_name = "materialize"
+    # schema_name = "public"
+  }
+
+  upstream_name = "events"
+  include_key   = true
+
+  key_format {
+    text = true
+  }
+  value_format {
+    text = true
+  }
+  envelope {
+    upsert = true
+  }
+}
+
+# CREATE TABLE "materialize"."schema"."events"
+#   FROM SOURCE "materialize"."public"."source_kafka" (REFERENCE "events")
+#   KEY FORMAT TEXT VALUE FORMAT TEXT
+#   INCLUDE KEY
+#   ENVELOPE UPSERT;
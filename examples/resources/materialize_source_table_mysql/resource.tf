@@ -0,0 +1,19 @@
+resource "materialize_source_table_mysql" "example_source_table_mysql" {
+  name        = "customers"
+  schema_name = "schema"
+
+  source {
+    name = "source_mysql"
+    # Optional parameters
+    # database_name = "materialize"
+    # schema_name = "public"
+  }
+
+  upstream_name        = "customers"
+  upstream_schema_name = "shop"
+  exclude_columns      = ["password_hash"]
+}
+
+# CREATE TABLE "materialize"."schema"."customers"
+#   FROM SOURCE "materialize"."public"."source_mysql" (REFERENCE "shop"."customers")
+#   WITH (EXCLUDE COLUMNS ("password_hash"));
@@ -0,0 +1,20 @@
+resource "materialize_source_table_postgres" "example_source_table_postgres" {
+  name        = "orders"
+  schema_name = "schema"
+
+  source {
+    name = "source_postgres"
+    # Optional parameters
+    # database_name = "materialize"
+    # schema_name = "public"
+  }
+
+  upstream_name        = "orders"
+  upstream_schema_name = "public"
+  text_columns         = ["status"]
+  exclude_columns      = ["internal_notes"]
+}
+
+# CREATE TABLE "materialize"."schema"."orders"
+#   FROM SOURCE "materialize"."public"."source_postgres" (REFERENCE "public"."orders")
+#   WITH (TEXT COLUMNS ("status"), EXCLUDE COLUMNS ("internal_notes"));
@@ -31,4 +31,30 @@ resource "materialize_source_webhook" "example_webhook" {
 #   CHECK (
 #     WITH ( HEADERS, SECRET materialize.public.password AS secret)
 #     headers->'x-mz-api-key' = secret
-#   );
\ No newline at end of file
+#   );
+
+resource "materialize_source_webhook" "example_webhook_github" {
+  name         = "example_webhook_github"
+  cluster_name = materialize_cluster.cluster.name
+  body_format  = "json"
+
+  validation_preset {
+    type = "GITHUB"
+    secret {
+      name          = materialize_secret.github.name
+      database_name = materialize_secret.github.database_name
+      schema_name   = materialize_secret.github.schema_name
+    }
+  }
+}
+
+# CREATE SOURCE example_webhook_github IN CLUSTER cluster FROM WEBHOOK
+#   BODY FORMAT json
+#   CHECK (
+#     WITH (BODY BYTES, HEADERS, SECRET materialize.public.github AS secret BYTES)
+#     constant_time_eq(decode(split_part(headers->'x-hub-signature-256', '=', 2), 'hex'), hmac(body, secret, 'sha256'))
+#   );
+
+output "github_webhook_url" {
+  value = materialize_source_webhook.example_webhook_github.url
+}
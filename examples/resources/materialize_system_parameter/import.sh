@@ -0,0 +1,4 @@
+# System parameters can be imported using the parameter name:
+terraform import materialize_system_parameter.max_tables <region>:<parameter_name>
+
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,4 @@
+resource "materialize_system_parameter" "max_tables" {
+  name  = "max_tables"
+  value = "200"
+}
@@ -46,6 +46,41 @@ resource "materialize_cluster" "managed_cluster" {
   disk                          = true
 }
 
+resource "materialize_cluster" "cluster_blue" {
+  name               = "cluster_blue"
+  size               = "3xsmall"
+  replication_factor = 0
+
+  lifecycle {
+    ignore_changes = [name]
+  }
+}
+
+resource "materialize_cluster" "cluster_green" {
+  name               = "cluster_green"
+  size               = "3xsmall"
+  replication_factor = 0
+
+  lifecycle {
+    ignore_changes = [name]
+  }
+}
+
+resource "materialize_cluster_swap" "cluster_swap" {
+  cluster_name     = "cluster_blue"
+  swap_with        = "cluster_green"
+  trigger          = "v1"
+  wait_until_ready = true
+
+  depends_on = [materialize_cluster.cluster_blue, materialize_cluster.cluster_green]
+}
+
 data "materialize_cluster" "all" {}
 
 data "materialize_current_cluster" "default" {}
+
+data "materialize_cluster_replica_sizes" "all" {}
+
+output "cluster_replica_sizes" {
+  value = [for s in data.materialize_cluster_replica_sizes.all.sizes : s.size]
+}
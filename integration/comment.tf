@@ -0,0 +1,20 @@
+resource "materialize_comment" "auction_bids" {
+  object_type   = "SOURCE"
+  object_name   = "bids"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+  comment       = "subsource bids comment"
+
+  depends_on = [materialize_source_load_generator.load_generator_auction]
+}
+
+resource "materialize_comment" "auction_bids_amount" {
+  object_type   = "SOURCE"
+  object_name   = "bids"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+  column_name   = "amount"
+  comment       = "bid amount"
+
+  depends_on = [materialize_source_load_generator.load_generator_auction]
+}
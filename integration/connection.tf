@@ -182,6 +182,8 @@ resource "materialize_connection_ssh_tunnel" "ssh_connection" {
   host = "ssh_host"
   user = "ssh_user"
   port = 22
+
+  rotation_trigger = "initial"
 }
 
 resource "materialize_connection_kafka" "kafka_conn_ssh_default" {
@@ -16,8 +16,9 @@ resource "materialize_index" "loadgen_index" {
 }
 
 resource "materialize_index" "materialized_view_index" {
-  name         = "simple"
-  cluster_name = "default"
+  name             = "simple"
+  cluster_name     = "default"
+  wait_until_ready = true
 
   obj_name {
     name          = materialize_materialized_view.simple_materialized_view.name
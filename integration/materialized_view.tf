@@ -12,11 +12,12 @@ SQL
 }
 
 resource "materialize_materialized_view" "materialized_view_assertions" {
-  name               = "materialized_view_assertions"
-  schema_name        = materialize_schema.schema.name
-  database_name      = materialize_database.database.name
-  cluster_name       = "default"
-  not_null_assertion = ["id"]
+  name                 = "materialized_view_assertions"
+  schema_name          = materialize_schema.schema.name
+  database_name        = materialize_database.database.name
+  cluster_name         = "default"
+  not_null_assertion   = ["id"]
+  replacement_strategy = "create_then_swap"
 
   statement = <<SQL
 SELECT
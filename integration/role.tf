@@ -32,6 +32,18 @@ resource "materialize_grant_system_privilege" "role_1_system_createcluster" {
   privilege = "CREATECLUSTER"
 }
 
+resource "materialize_role_parameter" "role_1_cluster" {
+  role_name      = materialize_role.role_1.name
+  variable_name  = "cluster"
+  variable_value = "quickstart"
+}
+
+resource "materialize_role_parameter" "role_1_statement_timeout" {
+  role_name      = materialize_role.role_1.name
+  variable_name  = "statement_timeout"
+  variable_value = "30s"
+}
+
 output "qualified_role" {
   value = materialize_role.role_1.qualified_sql_name
 }
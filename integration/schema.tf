@@ -25,6 +25,34 @@ resource "materialize_schema_grant_default_privilege" "example" {
   database_name    = materialize_database.database.name
 }
 
+resource "materialize_schema" "schema_blue" {
+  name          = "example_schema_blue"
+  database_name = materialize_database.database.name
+
+  lifecycle {
+    ignore_changes = [name]
+  }
+}
+
+resource "materialize_schema" "schema_green" {
+  name          = "example_schema_green"
+  database_name = materialize_database.database.name
+
+  lifecycle {
+    ignore_changes = [name]
+  }
+}
+
+resource "materialize_schema_swap" "schema_swap" {
+  schema_name      = "example_schema_blue"
+  swap_with        = "example_schema_green"
+  database_name    = materialize_database.database.name
+  trigger          = "v1"
+  wait_until_ready = true
+
+  depends_on = [materialize_schema.schema_blue, materialize_schema.schema_green]
+}
+
 output "qualified_schema" {
   value = materialize_schema.schema.qualified_sql_name
 }
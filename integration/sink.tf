@@ -86,8 +86,50 @@ resource "materialize_sink_kafka" "sink_kafka_cluster" {
   }
 }
 
+resource "materialize_sink_kafka" "sink_kafka_topic_options" {
+  name                     = "sink_kafka_topic_options"
+  schema_name              = materialize_schema.schema.name
+  database_name            = materialize_database.database.name
+  cluster_name             = materialize_cluster.cluster_sink.name
+  topic                    = "topic_options"
+  compression_type         = "gzip"
+  topic_partition_count    = 3
+  topic_replication_factor = 1
+  topic_config = {
+    "cleanup.policy" = "compact"
+  }
+  progress_group_id_prefix = "sink_kafka_topic_options"
+  transactional_id_prefix  = "sink_kafka_topic_options"
+  key                      = ["counter"]
+  key_not_enforced         = true
+  wait_for_running         = true
+  from {
+    name          = materialize_source_load_generator.load_generator.name
+    database_name = materialize_source_load_generator.load_generator.database_name
+    schema_name   = materialize_source_load_generator.load_generator.schema_name
+  }
+  kafka_connection {
+    name          = materialize_connection_kafka.kafka_connection.name
+    database_name = materialize_connection_kafka.kafka_connection.database_name
+    schema_name   = materialize_connection_kafka.kafka_connection.schema_name
+  }
+  key_format {
+    text = true
+  }
+  value_format {
+    json = true
+  }
+  envelope {
+    upsert = true
+  }
+}
+
 output "qualified_sink_kafka" {
   value = materialize_sink_kafka.sink_kafka.qualified_sql_name
 }
 
+output "sink_kafka_topic_options_status" {
+  value = materialize_sink_kafka.sink_kafka_topic_options.status
+}
+
 data "materialize_sink" "all" {}
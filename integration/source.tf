@@ -62,11 +62,40 @@ resource "materialize_source_load_generator" "load_generator_tpch" {
   }
 }
 
+resource "materialize_source_load_generator" "load_generator_key_value" {
+  name                = "load_gen_key_value"
+  schema_name         = materialize_schema.schema.name
+  database_name       = materialize_database.database.name
+  cluster_name        = materialize_cluster.cluster_source.name
+  load_generator_type = "KEY VALUE"
+
+  key_value_options {
+    keys            = 16
+    snapshot_rounds = 1
+    value_size      = 8
+    seed            = 42
+    partitions      = 2
+    batch_size      = 2
+  }
+}
+
+resource "materialize_source_load_generator" "load_generator_clock" {
+  name                = "load_gen_clock"
+  schema_name         = materialize_schema.schema.name
+  database_name       = materialize_database.database.name
+  cluster_name        = materialize_cluster.cluster_source.name
+  load_generator_type = "CLOCK"
+
+  clock_options {
+    tick_interval = "1s"
+  }
+}
+
 resource "materialize_source_postgres" "example_source_postgres" {
-  name         = "source_postgres"
-  comment      = "source postgres comment"
-  size         = "3xsmall"
-  text_columns = ["table1.id"]
+  name              = "source_postgres"
+  comment           = "source postgres comment"
+  size              = "3xsmall"
+  wait_for_snapshot = true
 
   postgres_connection {
     name          = materialize_connection_postgres.postgres_connection.name
@@ -75,8 +104,9 @@ resource "materialize_source_postgres" "example_source_postgres" {
   }
   publication = "mz_source"
   table {
-    name  = "table1"
-    alias = "s1_table1"
+    name         = "table1"
+    alias        = "s1_table1"
+    text_columns = ["id"]
   }
   table {
     name  = "table2"
@@ -88,10 +118,11 @@ resource "materialize_source_postgres" "example_source_postgres" {
 }
 
 resource "materialize_source_postgres" "example_source_postgres_schema" {
-  name        = "source_postgres_schema"
-  size        = "3xsmall"
-  publication = "mz_source"
-  schema      = ["PUBLIC"]
+  name             = "source_postgres_schema"
+  size             = "3xsmall"
+  publication      = "mz_source"
+  schema           = ["PUBLIC"]
+  wait_for_running = true
 
   postgres_connection {
     name          = materialize_connection_postgres.postgres_connection.name
@@ -123,6 +154,36 @@ resource "materialize_source_kafka" "example_source_kafka_format_text" {
   }
 }
 
+resource "materialize_source_kafka" "example_source_kafka_upsert" {
+  name                            = "source_kafka_upsert"
+  size                            = "3xsmall"
+  topic                           = "topic1"
+  group_id_prefix                 = "terraform_upsert"
+  topic_metadata_refresh_interval = "30s"
+
+  kafka_connection {
+    name          = materialize_connection_kafka.kafka_connection.name
+    schema_name   = materialize_connection_kafka.kafka_connection.schema_name
+    database_name = materialize_connection_kafka.kafka_connection.database_name
+  }
+  key_format {
+    text = true
+  }
+  value_format {
+    text = true
+  }
+  envelope {
+    upsert = true
+    upsert_options {
+      value_decoding_errors {
+        inline {
+          enabled = true
+        }
+      }
+    }
+  }
+}
+
 resource "materialize_source_kafka" "example_source_kafka_format_bytes" {
   name  = "source_kafka_bytes"
   size  = "2xsmall"
@@ -189,6 +250,66 @@ resource "materialize_source_webhook" "example_webhook_source" {
   }
 }
 
+resource "materialize_source_webhook" "example_webhook_source_github" {
+  name         = "example_webhook_source_github"
+  cluster_name = materialize_cluster.cluster_source.name
+  body_format  = "json"
+
+  validation_preset {
+    type = "GITHUB"
+    secret {
+      name          = materialize_secret.postgres_password.name
+      database_name = materialize_secret.postgres_password.database_name
+      schema_name   = materialize_secret.postgres_password.schema_name
+    }
+  }
+}
+
+output "webhook_source_github_url" {
+  value = materialize_source_webhook.example_webhook_source_github.url
+}
+
+resource "materialize_source_table_postgres" "example_source_table_postgres" {
+  name    = "source_table_postgres"
+  comment = "source table postgres comment"
+
+  source {
+    name          = materialize_source_postgres.example_source_postgres.name
+    schema_name   = materialize_source_postgres.example_source_postgres.schema_name
+    database_name = materialize_source_postgres.example_source_postgres.database_name
+  }
+  upstream_name        = "table3"
+  upstream_schema_name = "public"
+  text_columns         = ["id"]
+}
+
+resource "materialize_source_table_kafka" "example_source_table_kafka" {
+  name = "source_table_kafka"
+
+  source {
+    name          = materialize_source_kafka.example_source_kafka_format_text.name
+    schema_name   = materialize_source_kafka.example_source_kafka_format_text.schema_name
+    database_name = materialize_source_kafka.example_source_kafka_format_text.database_name
+  }
+  upstream_name = "topic1"
+  include_key   = true
+
+  key_format {
+    text = true
+  }
+  value_format {
+    text = true
+  }
+}
+
+resource "materialize_table_grant" "source_table_grant_select" {
+  role_name     = materialize_role.role_1.name
+  privilege     = "SELECT"
+  table_name    = materialize_source_table_postgres.example_source_table_postgres.name
+  schema_name   = materialize_source_table_postgres.example_source_table_postgres.schema_name
+  database_name = materialize_source_table_postgres.example_source_table_postgres.database_name
+}
+
 resource "materialize_source_grant" "source_grant_select" {
   role_name     = materialize_role.role_1.name
   privilege     = "SELECT"
@@ -0,0 +1,6 @@
+resource "materialize_system_parameter" "max_tables" {
+  name  = "max_tables"
+  value = "200"
+}
+
+data "materialize_system_parameters" "all" {}
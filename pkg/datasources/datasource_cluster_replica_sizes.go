@@ -0,0 +1,88 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+func ClusterReplicaSizes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: clusterReplicaSizesRead,
+		Schema: map[string]*schema.Schema{
+			"sizes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cluster replica sizes available in the region, ordered by credits per hour",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"processes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"workers": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cpu_nano_cores": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"credits_per_hour": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterReplicaSizesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dataSource, err := materialize.ListClusterReplicaSizes(meta.(*sqlx.DB))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sizeFormats := []map[string]interface{}{}
+	for _, p := range dataSource {
+		sizeMap := map[string]interface{}{}
+
+		sizeMap["size"] = p.Size.String
+		sizeMap["processes"] = p.Processes.Int64
+		sizeMap["workers"] = p.Workers.Int64
+		sizeMap["cpu_nano_cores"] = p.CpuNanoCores.Int64
+		sizeMap["memory_bytes"] = p.MemoryBytes.Int64
+		sizeMap["disk_bytes"] = p.DiskBytes.Int64
+		sizeMap["credits_per_hour"] = p.CreditsPerHour.Float64
+
+		sizeFormats = append(sizeFormats, sizeMap)
+	}
+
+	if err := d.Set("sizes", sizeFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion("cluster_replica_sizes"))
+	return diags
+}
@@ -0,0 +1,68 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+func SystemParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: systemParametersRead,
+		Schema: map[string]*schema.Schema{
+			"parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The configuration parameters visible to the current user and their current values",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func systemParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dataSource, err := materialize.ListSystemParameters(meta.(*sqlx.DB))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameterFormats := []map[string]interface{}{}
+	for _, p := range dataSource {
+		parameterMap := map[string]interface{}{}
+
+		parameterMap["name"] = p.Name.String
+		parameterMap["value"] = p.Setting.String
+		parameterMap["description"] = p.Description.String
+
+		parameterFormats = append(parameterFormats, parameterMap)
+	}
+
+	if err := d.Set("parameters", parameterFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion("system_parameters"))
+	return diags
+}
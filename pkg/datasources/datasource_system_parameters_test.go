@@ -0,0 +1,32 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemParametersDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{}
+	d := schema.TestResourceDataRaw(t, SystemParameters().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockSystemParametersScan(mock)
+
+		if err := systemParametersRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("max_tables", d.Get("parameters.0.name"))
+		r.Equal("100", d.Get("parameters.0.value"))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
@@ -89,12 +90,7 @@ func (b *ClusterBuilder) Create() error {
 		}
 
 		if len(b.availabilityZones) > 0 {
-			var az []string
-			for _, z := range b.availabilityZones {
-				f := QuoteString(z)
-				az = append(az, f)
-			}
-			a := fmt.Sprintf(` AVAILABILITY ZONES = [%s]`, strings.Join(az[:], ","))
+			a := fmt.Sprintf(` AVAILABILITY ZONES = %s`, availabilityZones(b.availabilityZones))
 			p = append(p, a)
 		}
 
@@ -125,16 +121,43 @@ func (b *ClusterBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+func availabilityZones(zones []string) string {
+	var az []string
+	for _, z := range zones {
+		az = append(az, QuoteString(z))
+	}
+	return fmt.Sprintf(`[%s]`, strings.Join(az[:], ","))
+}
+
 func (b *ClusterBuilder) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
 }
 
+// Swap atomically exchanges the names of the cluster and another cluster.
+func (b *ClusterBuilder) Swap(target string) error {
+	q := fmt.Sprintf(`ALTER CLUSTER %s SWAP WITH %s;`, b.QualifiedName(), QuoteIdentifier(target))
+	return b.ddl.exec(q)
+}
+
 func (b *ClusterBuilder) Resize(newSize string) error {
 	q := fmt.Sprintf(`ALTER CLUSTER %s SET (SIZE '%s');`, b.QualifiedName(), newSize)
 	return b.ddl.exec(q)
 }
 
+// ResizeGraceful resizes a managed cluster without downtime. Replicas of the
+// new size are created alongside the existing replicas and the resize is only
+// finalized once they are hydrated. If they do not hydrate within the timeout
+// the new replicas are dropped and the cluster keeps its current size.
+func (b *ClusterBuilder) ResizeGraceful(newSize string, timeout time.Duration) error {
+	q := fmt.Sprintf(`ALTER CLUSTER %s SET (SIZE = %s) WITH (WAIT UNTIL READY (TIMEOUT = %s, ON TIMEOUT = 'ROLLBACK'));`,
+		b.QualifiedName(),
+		QuoteString(newSize),
+		QuoteString(fmt.Sprintf("%ds", int(timeout.Seconds()))),
+	)
+	return b.ddl.exec(q)
+}
+
 func (b *ClusterBuilder) SetDisk(disk bool) error {
 	q := fmt.Sprintf(`ALTER CLUSTER %s SET (DISK %t);`, b.QualifiedName(), disk)
 	return b.ddl.exec(q)
@@ -145,9 +168,8 @@ func (b *ClusterBuilder) SetReplicationFactor(newReplicationFactor int) error {
 	return b.ddl.exec(q)
 }
 
-func (b *ClusterBuilder) SetAvailabilityZones(availabilityZones []string) error {
-	az := strings.Join(availabilityZones[:], ",")
-	q := fmt.Sprintf(`ALTER CLUSTER %s SET (AVAILABILITY ZONES = [%s]);`, b.QualifiedName(), az)
+func (b *ClusterBuilder) SetAvailabilityZones(zones []string) error {
+	q := fmt.Sprintf(`ALTER CLUSTER %s SET (AVAILABILITY ZONES = %s);`, b.QualifiedName(), availabilityZones(zones))
 	return b.ddl.exec(q)
 }
 
@@ -174,6 +196,7 @@ type ClusterParams struct {
 	Size              sql.NullString `db:"size"`
 	ReplicationFactor sql.NullInt64  `db:"replication_factor"`
 	Disk              sql.NullBool   `db:"disk"`
+	AvailabilityZones pq.StringArray `db:"availability_zones"`
 	Comment           sql.NullString `db:"comment"`
 	OwnerName         sql.NullString `db:"owner_name"`
 	Privileges        pq.StringArray `db:"privileges"`
@@ -187,12 +210,23 @@ var clusterQuery = NewBaseQuery(`
 		mz_clusters.size,
 		mz_clusters.replication_factor,
 		mz_clusters.disk,
+		zones.availability_zones,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_clusters.privileges
 	FROM mz_clusters
 	JOIN mz_roles
 		ON mz_clusters.owner_id = mz_roles.id
+	LEFT JOIN (
+		SELECT cluster_id, array_agg(availability_zone) AS availability_zones
+		FROM (
+			SELECT DISTINCT cluster_id, availability_zone
+			FROM mz_cluster_replicas
+			WHERE availability_zone IS NOT NULL
+		) replica_zones
+		GROUP BY cluster_id
+	) zones
+		ON mz_clusters.id = zones.cluster_id
 	LEFT JOIN (
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -0,0 +1,39 @@
+package materialize
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ClusterReplicaSizeParams struct {
+	Size           sql.NullString  `db:"size"`
+	Processes      sql.NullInt64   `db:"processes"`
+	Workers        sql.NullInt64   `db:"workers"`
+	CpuNanoCores   sql.NullInt64   `db:"cpu_nano_cores"`
+	MemoryBytes    sql.NullInt64   `db:"memory_bytes"`
+	DiskBytes      sql.NullInt64   `db:"disk_bytes"`
+	CreditsPerHour sql.NullFloat64 `db:"credits_per_hour"`
+}
+
+var clusterReplicaSizeQuery = NewBaseQuery(`
+	SELECT
+		mz_cluster_replica_sizes.size,
+		mz_cluster_replica_sizes.processes,
+		mz_cluster_replica_sizes.workers,
+		mz_cluster_replica_sizes.cpu_nano_cores,
+		mz_cluster_replica_sizes.memory_bytes,
+		mz_cluster_replica_sizes.disk_bytes,
+		mz_cluster_replica_sizes.credits_per_hour
+	FROM mz_cluster_replica_sizes`).Order("mz_cluster_replica_sizes.credits_per_hour, mz_cluster_replica_sizes.size")
+
+func ListClusterReplicaSizes(conn *sqlx.DB) ([]ClusterReplicaSizeParams, error) {
+	q := clusterReplicaSizeQuery.QueryPredicate(map[string]string{})
+
+	var c []ClusterReplicaSizeParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,26 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListClusterReplicaSizes(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockClusterReplicaSizeScan(mock)
+
+		sizes, err := ListClusterReplicaSizes(db)
+		r.NoError(err)
+		r.Len(sizes, 2)
+		r.Equal("25cc", sizes[0].Size.String)
+		r.Equal(int64(1), sizes[0].Processes.Int64)
+		r.Equal(0.25, sizes[0].CreditsPerHour.Float64)
+		r.Equal("100cc", sizes[1].Size.String)
+	})
+}
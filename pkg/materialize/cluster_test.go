@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
@@ -90,6 +91,43 @@ func TestClusterManagedAllCreate(t *testing.T) {
 	})
 }
 
+func TestClusterResize(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(SIZE 'medium'\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		if err := NewClusterBuilder(db, o).Resize("medium"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClusterResizeGraceful(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CLUSTER "cluster" SET \(SIZE = 'medium'\) WITH \(WAIT UNTIL READY \(TIMEOUT = '600s', ON TIMEOUT = 'ROLLBACK'\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		if err := NewClusterBuilder(db, o).ResizeGraceful("medium", 10*time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestClusterSetAvailabilityZones(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CLUSTER "cluster" SET \(AVAILABILITY ZONES = \['use1-az1','use1-az2'\]\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "cluster"}
+		if err := NewClusterBuilder(db, o).SetAvailabilityZones([]string{"use1-az1", "use1-az2"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestClusterDrop(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP CLUSTER "cluster";`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -100,3 +138,13 @@ func TestClusterDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestClusterSwap(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SWAP WITH "cluster_green";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewClusterBuilder(db, MaterializeObject{Name: "cluster"}).Swap("cluster_green"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -2,6 +2,8 @@ package materialize
 
 import (
 	"database/sql"
+	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -89,3 +91,73 @@ func ListIndexColumns(conn *sqlx.DB, indexiId string) ([]IndexColumnParams, erro
 
 	return c, nil
 }
+
+type RelationColumnParams struct {
+	Id       sql.NullString `db:"id"`
+	Name     sql.NullString `db:"name"`
+	Position sql.NullString `db:"position"`
+	Nullable sql.NullBool   `db:"nullable"`
+	Type     sql.NullString `db:"type"`
+}
+
+var relationColumnQuery = NewBaseQuery(`
+	SELECT
+		mz_columns.id,
+		mz_columns.name,
+		mz_columns.position,
+		mz_columns.nullable,
+		mz_columns.type
+	FROM mz_columns
+	JOIN mz_objects
+		ON mz_columns.id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`).Order("mz_columns.position")
+
+// Columns of any relation (table, source, view or materialized view) by name
+func ListRelationColumns(conn *sqlx.DB, obj MaterializeObject) ([]RelationColumnParams, error) {
+	p := map[string]string{
+		"mz_objects.name":   obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q := relationColumnQuery.QueryPredicate(p)
+
+	var c []RelationColumnParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type QueryColumnParams struct {
+	Name string
+	Type string
+}
+
+// Columns a select statement returns, read from the result description
+// without fetching any rows
+func ListQueryColumns(conn *sqlx.DB, selectStmt string) ([]QueryColumnParams, error) {
+	s := strings.TrimSuffix(strings.TrimSpace(selectStmt), ";")
+	q := fmt.Sprintf(`SELECT * FROM (%s) LIMIT 0;`, s)
+
+	rows, err := conn.Query(q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	t, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	var c []QueryColumnParams
+	for _, ct := range t {
+		c = append(c, QueryColumnParams{Name: ct.Name(), Type: ct.DatabaseTypeName()})
+	}
+
+	return c, rows.Err()
+}
@@ -1,7 +1,9 @@
 package materialize
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -30,3 +32,94 @@ func (b *CommentBuilder) Column(column, comment string) error {
 	q := fmt.Sprintf(`COMMENT ON COLUMN %s.%s IS %s;`, b.object.QualifiedName(), col, c)
 	return b.ddl.exec(q)
 }
+
+func (b *CommentBuilder) ResetObject() error {
+	q := fmt.Sprintf(`COMMENT ON %s %s IS NULL;`, b.object.ObjectType, b.object.QualifiedName())
+	return b.ddl.exec(q)
+}
+
+func (b *CommentBuilder) ResetColumn(column string) error {
+	col := QuoteIdentifier(column)
+	q := fmt.Sprintf(`COMMENT ON COLUMN %s.%s IS NULL;`, b.object.QualifiedName(), col)
+	return b.ddl.exec(q)
+}
+
+// Object types as they are stored in mz_internal.mz_comments
+func CommentObjectType(objectType string) string {
+	return strings.ReplaceAll(strings.ToLower(objectType), " ", "-")
+}
+
+type CommentParams struct {
+	ObjectId     sql.NullString `db:"id"`
+	ObjectType   sql.NullString `db:"object_type"`
+	Comment      sql.NullString `db:"comment"`
+	ColumnName   sql.NullString `db:"column_name"`
+	ObjectName   sql.NullString `db:"object_name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	ClusterName  sql.NullString `db:"cluster_name"`
+}
+
+// Resolves the names of any commentable object alongside the comment
+var commentQuery = `
+	SELECT
+		comments.id,
+		comments.object_type,
+		comments.comment,
+		mz_columns.name AS column_name,
+		objects.name AS object_name,
+		objects.schema_name,
+		objects.database_name,
+		objects.cluster_name
+	FROM mz_internal.mz_comments comments
+	JOIN (
+		SELECT mz_objects.id, mz_objects.type AS object_type, mz_objects.name, mz_schemas.name AS schema_name, mz_databases.name AS database_name, NULL AS cluster_name
+		FROM mz_objects
+		JOIN mz_schemas
+			ON mz_objects.schema_id = mz_schemas.id
+		LEFT JOIN mz_databases
+			ON mz_schemas.database_id = mz_databases.id
+		UNION ALL
+		SELECT mz_schemas.id, 'schema', mz_schemas.name, NULL, mz_databases.name, NULL
+		FROM mz_schemas
+		LEFT JOIN mz_databases
+			ON mz_schemas.database_id = mz_databases.id
+		UNION ALL
+		SELECT id, 'database', name, NULL, NULL, NULL FROM mz_databases
+		UNION ALL
+		SELECT id, 'cluster', name, NULL, NULL, NULL FROM mz_clusters
+		UNION ALL
+		SELECT mz_cluster_replicas.id, 'cluster-replica', mz_cluster_replicas.name, NULL, NULL, mz_clusters.name
+		FROM mz_cluster_replicas
+		JOIN mz_clusters
+			ON mz_cluster_replicas.cluster_id = mz_clusters.id
+		UNION ALL
+		SELECT id, 'role', name, NULL, NULL, NULL FROM mz_roles
+	) objects
+		ON comments.id = objects.id
+		AND comments.object_type = objects.object_type
+	LEFT JOIN mz_columns
+		ON comments.id = mz_columns.id
+		AND comments.object_sub_id = mz_columns.position`
+
+func ScanComment(conn *sqlx.DB, objectType, objectId, columnName string) (CommentParams, error) {
+	p := map[string]string{
+		"comments.id":          objectId,
+		"comments.object_type": CommentObjectType(objectType),
+	}
+
+	b := NewBaseQuery(commentQuery)
+	if columnName != "" {
+		p["mz_columns.name"] = columnName
+	} else {
+		b.CustomPredicate([]string{"comments.object_sub_id IS NULL"})
+	}
+	q := b.QueryPredicate(p)
+
+	var c CommentParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
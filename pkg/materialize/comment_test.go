@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCommentObject(t *testing.T) {
@@ -31,3 +32,32 @@ func TestCommentColumn(t *testing.T) {
 		}
 	})
 }
+
+func TestCommentResetObject(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON SINK "database"."schema"."sink" IS NULL;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{ObjectType: "SINK", Name: "sink", DatabaseName: "database", SchemaName: "schema"}
+		if err := NewCommentBuilder(db, o).ResetObject(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestCommentResetColumn(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."table"."column" IS NULL;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{ObjectType: "TABLE", Name: "table", DatabaseName: "database", SchemaName: "schema"}
+		if err := NewCommentBuilder(db, o).ResetColumn("column"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestCommentObjectType(t *testing.T) {
+	r := require.New(t)
+	r.Equal("materialized-view", CommentObjectType("MATERIALIZED VIEW"))
+	r.Equal("cluster-replica", CommentObjectType("CLUSTER REPLICA"))
+	r.Equal("table", CommentObjectType("TABLE"))
+}
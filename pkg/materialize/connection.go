@@ -2,6 +2,9 @@ package materialize
 
 import (
 	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
@@ -54,6 +57,66 @@ func (b *Connection) Drop() error {
 	return b.ddl.drop(qn)
 }
 
+// ConnectionOption is a single option changed with ALTER CONNECTION. An option
+// with an empty value is reset to its default.
+type ConnectionOption struct {
+	Name  string
+	Value string
+}
+
+func StringOption(name, value string) ConnectionOption {
+	if value == "" {
+		return ConnectionOption{Name: name}
+	}
+	return ConnectionOption{Name: name, Value: QuoteString(value)}
+}
+
+func IntOption(name string, value int) ConnectionOption {
+	return ConnectionOption{Name: name, Value: strconv.Itoa(value)}
+}
+
+func ValueSecretOption(name string, value ValueSecretStruct) ConnectionOption {
+	if value.Secret.Name != "" {
+		return SecretOption(name, value.Secret)
+	}
+	return StringOption(name, value.Text)
+}
+
+func SecretOption(name string, value IdentifierSchemaStruct) ConnectionOption {
+	if value.Name == "" {
+		return ConnectionOption{Name: name}
+	}
+	return ConnectionOption{Name: name, Value: fmt.Sprintf(`SECRET %s`, value.QualifiedName())}
+}
+
+func IdentifierOption(name string, value IdentifierSchemaStruct) ConnectionOption {
+	if value.Name == "" {
+		return ConnectionOption{Name: name}
+	}
+	return ConnectionOption{Name: name, Value: value.QualifiedName()}
+}
+
+func (b *Connection) Alter(options []ConnectionOption, validate bool) error {
+	actions := []string{}
+	for _, o := range options {
+		if o.Value == "" {
+			actions = append(actions, fmt.Sprintf(`RESET (%s)`, o.Name))
+		} else {
+			actions = append(actions, fmt.Sprintf(`SET (%s = %s)`, o.Name, o.Value))
+		}
+	}
+
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`ALTER CONNECTION %s %s`, b.QualifiedName(), strings.Join(actions, ", ")))
+
+	if !validate {
+		q.WriteString(` WITH (VALIDATE = false)`)
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
+
 type ConnectionParams struct {
 	ConnectionId   sql.NullString `db:"id"`
 	ConnectionName sql.NullString `db:"connection_name"`
@@ -56,6 +56,7 @@ type ConnectionAwsPrivatelinkParams struct {
 	DatabaseName   sql.NullString `db:"database_name"`
 	Comment        sql.NullString `db:"comment"`
 	Principal      sql.NullString `db:"principal"`
+	Status         sql.NullString `db:"status"`
 	OwnerName      sql.NullString `db:"owner_name"`
 }
 
@@ -66,6 +67,7 @@ var connectionAwsPrivatelinkQuery = NewBaseQuery(`
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
 		mz_aws_privatelink_connections.principal,
+		mz_aws_privatelink_connection_statuses.status,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name
 	FROM mz_connections
@@ -75,6 +77,8 @@ var connectionAwsPrivatelinkQuery = NewBaseQuery(`
 		ON mz_schemas.database_id = mz_databases.id
 	LEFT JOIN mz_aws_privatelink_connections
 		ON mz_connections.id = mz_aws_privatelink_connections.id
+	LEFT JOIN mz_internal.mz_aws_privatelink_connection_statuses
+		ON mz_connections.id = mz_aws_privatelink_connection_statuses.id
 	JOIN mz_roles
 		ON mz_connections.owner_id = mz_roles.id
 	LEFT JOIN (
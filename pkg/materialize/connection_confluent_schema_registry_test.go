@@ -43,3 +43,22 @@ func TestConnectionConfluentSchemaRegistryUsernameSecretCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionConfluentSchemaRegistryAlter(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."csr_conn" SET \(URL = 'http://registry:8081'\), SET \(AWS PRIVATELINK = "database"."schema"."privatelink"\), RESET \(PASSWORD\) WITH \(VALIDATE = false\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionConfluentSchemaRegistryBuilder(db, connConfluentSchema)
+		options := []ConnectionOption{
+			StringOption("URL", "http://registry:8081"),
+			IdentifierOption("AWS PRIVATELINK", IdentifierSchemaStruct{Name: "privatelink", DatabaseName: "database", SchemaName: "schema"}),
+			SecretOption("PASSWORD", IdentifierSchemaStruct{}),
+		}
+
+		if err := b.Alter(options, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -115,12 +115,9 @@ func (b *ConnectionKafkaBuilder) Validate(validate bool) *ConnectionKafkaBuilder
 	return b
 }
 
-func (b *ConnectionKafkaBuilder) Create() error {
-	q := strings.Builder{}
-	q.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO KAFKA`, b.QualifiedName()))
-
+func kafkaBrokers(b []KafkaBroker) string {
 	var brokers = []string{}
-	for _, broker := range b.kafkaBrokers {
+	for _, broker := range b {
 		fb := strings.Builder{}
 		fb.WriteString(QuoteString(broker.Broker))
 
@@ -160,7 +157,18 @@ func (b *ConnectionKafkaBuilder) Create() error {
 		}
 		brokers = append(brokers, fb.String())
 	}
-	q.WriteString(fmt.Sprintf(` (BROKERS (%s)`, strings.Join(brokers[:], ", ")))
+	return fmt.Sprintf(`(%s)`, strings.Join(brokers[:], ", "))
+}
+
+func KafkaBrokersOption(b []KafkaBroker) ConnectionOption {
+	return ConnectionOption{Name: "BROKERS", Value: kafkaBrokers(b)}
+}
+
+func (b *ConnectionKafkaBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO KAFKA`, b.QualifiedName()))
+
+	q.WriteString(fmt.Sprintf(` (BROKERS %s`, kafkaBrokers(b.kafkaBrokers)))
 
 	if b.kafkaSSHTunnel.Name != "" {
 		q.WriteString(fmt.Sprintf(`, SSH TUNNEL %s`,
@@ -225,3 +225,40 @@ func TestConnectionKafkaAwsPrivatelinkCreate(t *testing.T) {
 	})
 
 }
+
+func TestConnectionKafkaAlter(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."kafka_conn" SET \(BROKERS = \('b-1:9092', 'b-2:9092' USING SSH TUNNEL "database"."schema"."ssh_conn"\)\), SET \(SASL USERNAME = SECRET "database"."schema"."user"\), RESET \(SSL KEY\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionKafkaBuilder(db, connKafka)
+		options := []ConnectionOption{
+			KafkaBrokersOption([]KafkaBroker{
+				{Broker: "b-1:9092"},
+				{Broker: "b-2:9092", SSHTunnel: IdentifierSchemaStruct{Name: "ssh_conn", DatabaseName: "database", SchemaName: "schema"}},
+			}),
+			ValueSecretOption("SASL USERNAME", ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", DatabaseName: "database", SchemaName: "schema"}}),
+			SecretOption("SSL KEY", IdentifierSchemaStruct{}),
+		}
+
+		if err := b.Alter(options, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionKafkaAlterNoValidate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."kafka_conn" SET \(SECURITY PROTOCOL = 'SASL_SSL'\) WITH \(VALIDATE = false\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionKafkaBuilder(db, connKafka)
+		options := []ConnectionOption{StringOption("SECURITY PROTOCOL", "SASL_SSL")}
+
+		if err := b.Alter(options, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -95,3 +95,23 @@ func TestConnectionPostgresSslCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionPostgresAlter(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."postgres_conn" SET \(HOST = 'replica.example.com'\), SET \(PORT = 5433\), SET \(USER = 'postgres'\), RESET \(SSH TUNNEL\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionPostgresBuilder(db, connPostgres)
+		options := []ConnectionOption{
+			StringOption("HOST", "replica.example.com"),
+			IntOption("PORT", 5433),
+			ValueSecretOption("USER", ValueSecretStruct{Text: "postgres"}),
+			IdentifierOption("SSH TUNNEL", IdentifierSchemaStruct{}),
+		}
+
+		if err := b.Alter(options, true); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -47,6 +47,13 @@ func (b *ConnectionSshTunnelBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+// RotateKeys generates a new SSH key pair for the tunnel. The previous
+// secondary key becomes the primary key.
+func (b *ConnectionSshTunnelBuilder) RotateKeys() error {
+	q := fmt.Sprintf(`ALTER CONNECTION %s ROTATE KEYS;`, b.QualifiedName())
+	return b.ddl.exec(q)
+}
+
 type ConnectionSshTunnelParams struct {
 	ConnectionId   sql.NullString `db:"id"`
 	ConnectionName sql.NullString `db:"connection_name"`
@@ -50,8 +50,10 @@ type SinkAvroFormatSpec struct {
 }
 
 type SinkFormatSpecStruct struct {
-	Avro *SinkAvroFormatSpec
-	Json bool
+	Avro  *SinkAvroFormatSpec
+	Json  bool
+	Text  bool
+	Bytes bool
 }
 
 func GetFormatSpecStruc(v interface{}) SourceFormatSpecStruct {
@@ -148,5 +150,11 @@ func GetSinkFormatSpecStruc(v interface{}) SinkFormatSpecStruct {
 	if v, ok := u["json"]; ok {
 		format.Json = v.(bool)
 	}
+	if v, ok := u["text"]; ok {
+		format.Text = v.(bool)
+	}
+	if v, ok := u["bytes"]; ok {
+		format.Bytes = v.(bool)
+	}
 	return format
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/rand"
 
 	"github.com/jackc/pgx"
 	"github.com/jmoiron/sqlx"
@@ -25,6 +26,7 @@ const (
 	BaseSink         EntityType = "SINK"
 	BaseSource       EntityType = "SOURCE"
 	Secret           EntityType = "SECRET"
+	SystemParameter  EntityType = "SYSTEM"
 	Table            EntityType = "TABLE"
 	BaseType         EntityType = "TYPE"
 	View             EntityType = "VIEW"
@@ -43,33 +45,86 @@ func (b *Builder) exec(statement string) error {
 	_, err := b.conn.Exec(statement)
 	if err != nil {
 		log.Printf("[DEBUG] error executing: %s", statement)
-		var pgErr pgx.PgError
-		pgErr, ok := err.(pgx.PgError)
-		if ok {
-			msg := fmt.Sprintf("%s: %s", pgErr.Severity, pgErr.Message)
-			if pgErr.Detail != "" {
-				msg += fmt.Sprintf(" DETAIL: %s", pgErr.Detail)
-			}
-			if pgErr.Hint != "" {
-				msg += fmt.Sprintf(" HINT: %s", pgErr.Hint)
+		return execError(err)
+	}
+
+	return nil
+}
+
+// execTransaction executes the statements within a single transaction, so
+// either all of them take effect or none do
+func (b *Builder) execTransaction(statements ...string) error {
+	tx, err := b.conn.Beginx()
+	if err != nil {
+		return execError(err)
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			log.Printf("[DEBUG] error executing: %s", statement)
+			if rerr := tx.Rollback(); rerr != nil {
+				log.Printf("[DEBUG] error rolling back: %s", rerr)
 			}
-			msg += fmt.Sprintf(" (SQLSTATE %s)", pgErr.SQLState())
-			return errors.New(msg)
+			return execError(err)
 		}
-		return err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return execError(err)
+	}
 	return nil
 }
 
+func execError(err error) error {
+	pgErr, ok := err.(pgx.PgError)
+	if ok {
+		msg := fmt.Sprintf("%s: %s", pgErr.Severity, pgErr.Message)
+		if pgErr.Detail != "" {
+			msg += fmt.Sprintf(" DETAIL: %s", pgErr.Detail)
+		}
+		if pgErr.Hint != "" {
+			msg += fmt.Sprintf(" HINT: %s", pgErr.Hint)
+		}
+		msg += fmt.Sprintf(" (SQLSTATE %s)", pgErr.SQLState())
+		return errors.New(msg)
+	}
+	return err
+}
+
+// replacementName names an object that stands in for name while it is being
+// replaced. The random suffix keeps it from colliding with existing objects,
+// including those left behind by an earlier failed replacement.
+func replacementName(name, role string) string {
+	return fmt.Sprintf("%s_%s_%08x", name, role, rand.Uint32())
+}
+
+// IsUndefinedObjectError reports errors of statements that reference a
+// database, schema or object which does not exist, such as objects that are
+// only created later in the same apply
+func IsUndefinedObjectError(err error) bool {
+	pgErr, ok := err.(pgx.PgError)
+	if !ok {
+		return false
+	}
+
+	switch pgErr.Code {
+	case "3D000", "3F000", "42P01":
+		return true
+	}
+	return false
+}
+
 func (b *Builder) drop(name string) error {
 	q := fmt.Sprintf(`DROP %s %s;`, b.entity, name)
 	return b.exec(q)
 }
 
 func (b *Builder) rename(oldName, newName string) error {
-	q := fmt.Sprintf(`ALTER %s %s RENAME TO %s;`, b.entity, oldName, newName)
-	return b.exec(q)
+	return b.exec(b.renameStatement(oldName, newName))
+}
+
+func (b *Builder) renameStatement(oldName, newName string) string {
+	return fmt.Sprintf(`ALTER %s %s RENAME TO %s;`, b.entity, oldName, newName)
 }
 
 func (b *Builder) resize(name, size string) error {
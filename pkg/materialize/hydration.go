@@ -0,0 +1,62 @@
+package materialize
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type HydrationStatusParams struct {
+	ObjectId    sql.NullString `db:"object_id"`
+	ObjectName  sql.NullString `db:"object_name"`
+	ReplicaId   sql.NullString `db:"replica_id"`
+	ReplicaName sql.NullString `db:"replica_name"`
+	ClusterId   sql.NullString `db:"cluster_id"`
+	Hydrated    sql.NullBool   `db:"hydrated"`
+}
+
+var hydrationStatusQuery = NewBaseQuery(`
+	SELECT
+		mz_hydration_statuses.object_id,
+		mz_objects.name AS object_name,
+		mz_hydration_statuses.replica_id,
+		mz_cluster_replicas.name AS replica_name,
+		mz_cluster_replicas.cluster_id,
+		mz_hydration_statuses.hydrated
+	FROM mz_internal.mz_hydration_statuses
+	JOIN mz_objects
+		ON mz_hydration_statuses.object_id = mz_objects.id
+	JOIN mz_cluster_replicas
+		ON mz_hydration_statuses.replica_id = mz_cluster_replicas.id`).Order("mz_cluster_replicas.name, mz_objects.name")
+
+// ListObjectHydrationStatuses returns the hydration status of an object on
+// every replica of the cluster that maintains it.
+func ListObjectHydrationStatuses(conn *sqlx.DB, objectId string) ([]HydrationStatusParams, error) {
+	p := map[string]string{"mz_hydration_statuses.object_id": objectId}
+	return listHydrationStatuses(conn, p)
+}
+
+// ListClusterHydrationStatuses returns the hydration status of every object
+// maintained by a cluster on each of its replicas.
+func ListClusterHydrationStatuses(conn *sqlx.DB, clusterId string) ([]HydrationStatusParams, error) {
+	p := map[string]string{"mz_cluster_replicas.cluster_id": clusterId}
+	return listHydrationStatuses(conn, p)
+}
+
+// ListSchemaHydrationStatuses returns the hydration status of every object in
+// a schema on each replica that maintains it.
+func ListSchemaHydrationStatuses(conn *sqlx.DB, schemaId string) ([]HydrationStatusParams, error) {
+	p := map[string]string{"mz_objects.schema_id": schemaId}
+	return listHydrationStatuses(conn, p)
+}
+
+func listHydrationStatuses(conn *sqlx.DB, predicate map[string]string) ([]HydrationStatusParams, error) {
+	q := hydrationStatusQuery.QueryPredicate(predicate)
+
+	var h []HydrationStatusParams
+	if err := conn.Select(&h, q); err != nil {
+		return h, err
+	}
+
+	return h, nil
+}
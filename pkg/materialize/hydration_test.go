@@ -0,0 +1,50 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListObjectHydrationStatuses(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_hydration_statuses.object_id = 'u1'`, false)
+
+		h, err := ListObjectHydrationStatuses(db, "u1")
+		r.NoError(err)
+		r.Len(h, 2)
+		r.Equal("r1", h[0].ReplicaName.String)
+		r.True(h[0].Hydrated.Bool)
+		r.Equal("r2", h[1].ReplicaName.String)
+		r.False(h[1].Hydrated.Bool)
+	})
+}
+
+func TestListClusterHydrationStatuses(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_cluster_replicas.cluster_id = 'u1'`, true)
+
+		h, err := ListClusterHydrationStatuses(db, "u1")
+		r.NoError(err)
+		r.Len(h, 2)
+	})
+}
+
+func TestListSchemaHydrationStatuses(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_objects.schema_id = 'u1'`, true)
+
+		h, err := ListSchemaHydrationStatuses(db, "u1")
+		r.NoError(err)
+		r.Len(h, 2)
+	})
+}
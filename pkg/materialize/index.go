@@ -146,8 +146,14 @@ var indexQuery = NewBaseQuery(`
 		ON mz_indexes.id = comments.id`).
 	CustomPredicate([]string{"mz_objects.type IN ('source', 'view', 'materialized-view')"})
 
-func IndexId(conn *sqlx.DB, indexName string) (string, error) {
-	q := indexQuery.QueryPredicate(map[string]string{"mz_indexes.name": indexName})
+// Indexes belong to the schema of the object they are created on
+func IndexId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	p := map[string]string{
+		"mz_indexes.name":   obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q := indexQuery.QueryPredicate(p)
 
 	var c IndexParams
 	if err := conn.Get(&c, q); err != nil {
@@ -17,6 +17,8 @@ type MaterializedViewBuilder struct {
 	clusterName          string
 	notNullAssertions    []string
 	selectStmt           string
+	shadow               string
+	backup               string
 }
 
 func NewMaterializedViewBuilder(conn *sqlx.DB, obj MaterializeObject) *MaterializedViewBuilder {
@@ -48,9 +50,65 @@ func (b *MaterializedViewBuilder) SelectStmt(selectStmt string) *MaterializedVie
 }
 
 func (b *MaterializedViewBuilder) Create() error {
+	return b.create(b.QualifiedName())
+}
+
+// The new definition is built under a shadow name on the same cluster and
+// only swapped into place once it is hydrated
+func (b *MaterializedViewBuilder) ShadowName() string {
+	if b.shadow == "" {
+		b.shadow = replacementName(b.materializedViewName, "shadow")
+	}
+	return b.shadow
+}
+
+func (b *MaterializedViewBuilder) ShadowObject() MaterializeObject {
+	return MaterializeObject{ObjectType: "MATERIALIZED VIEW", Name: b.ShadowName(), SchemaName: b.schemaName, DatabaseName: b.databaseName}
+}
+
+func (b *MaterializedViewBuilder) shadowQualifiedName() string {
+	return QualifiedName(b.databaseName, b.schemaName, b.ShadowName())
+}
+
+func (b *MaterializedViewBuilder) CreateShadow() error {
+	return b.create(b.shadowQualifiedName())
+}
+
+func (b *MaterializedViewBuilder) DropShadow() error {
+	return b.ddl.drop(b.shadowQualifiedName())
+}
+
+// The materialized view is kept under a backup name while the shadow takes its place
+func (b *MaterializedViewBuilder) BackupName() string {
+	if b.backup == "" {
+		b.backup = replacementName(b.materializedViewName, "old")
+	}
+	return b.backup
+}
+
+func (b *MaterializedViewBuilder) backupQualifiedName() string {
+	return QualifiedName(b.databaseName, b.schemaName, b.BackupName())
+}
+
+// Replace moves the shadow into the place of the materialized view, which is
+// kept under the backup name until DropBackup. Both renames run in one
+// transaction so the materialized view is never left without a definition,
+// and the shadow is left to be dropped if they fail.
+func (b *MaterializedViewBuilder) Replace() error {
+	return b.ddl.execTransaction(
+		b.ddl.renameStatement(b.QualifiedName(), QualifiedName(b.BackupName())),
+		b.ddl.renameStatement(b.shadowQualifiedName(), QualifiedName(b.materializedViewName)),
+	)
+}
+
+func (b *MaterializedViewBuilder) DropBackup() error {
+	return b.ddl.drop(b.backupQualifiedName())
+}
+
+func (b *MaterializedViewBuilder) create(name string) error {
 	q := strings.Builder{}
 
-	q.WriteString(fmt.Sprintf(`CREATE MATERIALIZED VIEW %s`, b.QualifiedName()))
+	q.WriteString(fmt.Sprintf(`CREATE MATERIALIZED VIEW %s`, name))
 
 	if b.clusterName != "" {
 		q.WriteString(fmt.Sprintf(` IN CLUSTER %s`, QuoteIdentifier(b.clusterName)))
@@ -86,6 +144,7 @@ type MaterializedViewParams struct {
 	SchemaName           sql.NullString `db:"schema_name"`
 	DatabaseName         sql.NullString `db:"database_name"`
 	Cluster              sql.NullString `db:"cluster_name"`
+	Definition           sql.NullString `db:"definition"`
 	Comment              sql.NullString `db:"comment"`
 	OwnerName            sql.NullString `db:"owner_name"`
 	Privileges           pq.StringArray `db:"privileges"`
@@ -98,6 +157,7 @@ var materializedViewQuery = NewBaseQuery(`
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
 		mz_clusters.name AS cluster_name,
+		mz_materialized_views.definition,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_materialized_views.privileges
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"fmt"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -26,6 +27,79 @@ func TestMaterializedViewCreate(t *testing.T) {
 	})
 }
 
+func TestMaterializedViewCreateShadow(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" IN CLUSTER "cluster" AS SELECT 1 FROM t1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o)
+		b.ClusterName("cluster")
+		b.SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.CreateShadow(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMaterializedViewReplacementNames(t *testing.T) {
+	o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+	b := NewMaterializedViewBuilder(nil, o)
+
+	if b.ShadowName() != b.ShadowObject().Name || b.BackupName() != b.BackupName() {
+		t.Fatal("expected the replacement names to be stable for a builder")
+	}
+
+	if b.ShadowName() == NewMaterializedViewBuilder(nil, o).ShadowName() {
+		t.Fatalf("expected a unique shadow name, got %s twice", b.ShadowName())
+	}
+}
+
+func TestMaterializedViewReplace(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(fmt.Sprintf(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" RENAME TO "%s";`, b.BackupName())).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(fmt.Sprintf(`ALTER MATERIALIZED VIEW "database"."schema"."%s" RENAME TO "materialized_view";`, b.ShadowName())).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := b.Replace(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMaterializedViewReplaceRollback(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewMaterializedViewBuilder(db, o)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(fmt.Sprintf(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" RENAME TO "%s";`, b.BackupName())).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(fmt.Sprintf(`ALTER MATERIALIZED VIEW "database"."schema"."%s" RENAME TO "materialized_view";`, b.ShadowName())).WillReturnError(fmt.Errorf("rename failed"))
+		mock.ExpectRollback()
+
+		if err := b.Replace(); err == nil || err.Error() != "rename failed" {
+			t.Fatalf("expected the rename error, got %v", err)
+		}
+	})
+}
+
+func TestMaterializedViewDropBackup(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view_old_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewMaterializedViewBuilder(db, o).DropBackup(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestMaterializedViewDrop(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
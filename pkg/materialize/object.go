@@ -89,6 +89,18 @@ func ObjectId(conn *sqlx.DB, object MaterializeObject) (string, error) {
 
 	case "CLUSTER":
 		i, e = ClusterId(conn, object)
+
+	case "SINK":
+		i, e = SinkId(conn, object)
+
+	case "INDEX":
+		i, e = IndexId(conn, object)
+
+	case "CLUSTER REPLICA":
+		i, e = ClusterReplicaId(conn, object)
+
+	case "ROLE":
+		i, e = RoleId(conn, object.Name)
 	}
 
 	if e != nil {
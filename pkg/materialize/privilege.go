@@ -174,6 +174,36 @@ func (b *PrivilegeBuilder) Revoke() error {
 	return b.ddl.exec(q)
 }
 
+// GrantPrivileges grants the privileges of mz_aclitems, such as those of an
+// object being replaced, on the object. The privileges roles hold on objects
+// they granted themselves, such as those of the owner, are left out.
+func GrantPrivileges(conn *sqlx.DB, obj MaterializeObject, privileges []string) error {
+	b := Builder{conn, Privilege}
+	t := objectCompatibility(obj.ObjectType)
+
+	for _, p := range privileges {
+		item := ParseMzAclString(p)
+		if item.Grantee == item.Grantor || len(item.Privileges) == 0 {
+			continue
+		}
+
+		grantee := "PUBLIC"
+		if item.Grantee != "" && item.Grantee != "p" {
+			r, err := ScanRole(conn, item.Grantee)
+			if err != nil {
+				return err
+			}
+			grantee = QualifiedName(r.RoleName.String)
+		}
+
+		q := fmt.Sprintf(`GRANT %s ON %s %s TO %s;`, strings.Join(item.Privileges, ", "), t, obj.QualifiedName(), grantee)
+		if err := b.exec(q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *PrivilegeBuilder) GrantKey(region, objectId, roleId, privilege string) string {
 	return fmt.Sprintf(`%[1]s:GRANT|%[2]s|%[3]s|%[4]s|%[5]s`, region, b.object.ObjectType, objectId, roleId, privilege)
 }
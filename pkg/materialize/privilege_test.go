@@ -81,6 +81,19 @@ func TestPrivilegeRevoke(t *testing.T) {
 	})
 }
 
+func TestGrantPrivileges(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u2'`)
+		mock.ExpectExec(`GRANT SELECT ON TABLE "database"."schema"."view" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`GRANT SELECT ON TABLE "database"."schema"."view" TO PUBLIC;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{ObjectType: "VIEW", Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		if err := GrantPrivileges(db, o, []string{"u1=r/u1", "u2=r/u1", "p=r/u1"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestScanPrivileges(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		// Query Id
@@ -9,9 +9,12 @@ import (
 )
 
 type RoleBuilder struct {
-	ddl      Builder
-	roleName string
-	inherit  bool
+	ddl       Builder
+	roleName  string
+	inherit   bool
+	login     bool
+	superuser bool
+	password  string
 }
 
 func NewRoleBuilder(conn *sqlx.DB, obj MaterializeObject) *RoleBuilder {
@@ -30,6 +33,21 @@ func (b *RoleBuilder) Inherit() *RoleBuilder {
 	return b
 }
 
+func (b *RoleBuilder) Login() *RoleBuilder {
+	b.login = true
+	return b
+}
+
+func (b *RoleBuilder) Superuser() *RoleBuilder {
+	b.superuser = true
+	return b
+}
+
+func (b *RoleBuilder) Password(p string) *RoleBuilder {
+	b.password = p
+	return b
+}
+
 func (b *RoleBuilder) Create() error {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE ROLE %s`, b.QualifiedName()))
@@ -42,6 +60,20 @@ func (b *RoleBuilder) Create() error {
 		p = append(p, ` INHERIT`)
 	}
 
+	// LOGIN, SUPERUSER and PASSWORD only apply to self-managed deployments
+	// using password authentication
+	if b.login {
+		p = append(p, ` LOGIN`)
+	}
+
+	if b.superuser {
+		p = append(p, ` SUPERUSER`)
+	}
+
+	if b.password != "" {
+		p = append(p, fmt.Sprintf(` PASSWORD %s`, QuoteString(b.password)))
+	}
+
 	if len(p) > 0 {
 		f := strings.Join(p, "")
 		q.WriteString(f)
@@ -57,16 +89,26 @@ func (b *RoleBuilder) Alter(permission string) error {
 	return b.ddl.exec(q)
 }
 
+func (b *RoleBuilder) AlterPassword(password string) error {
+	p := `PASSWORD NULL`
+	if password != "" {
+		p = fmt.Sprintf(`PASSWORD %s`, QuoteString(password))
+	}
+	return b.Alter(p)
+}
+
 func (b *RoleBuilder) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
 }
 
 type RoleParams struct {
-	RoleId   sql.NullString `db:"id"`
-	RoleName sql.NullString `db:"role_name"`
-	Inherit  sql.NullBool   `db:"inherit"`
-	Comment  sql.NullString `db:"comment"`
+	RoleId    sql.NullString `db:"id"`
+	RoleName  sql.NullString `db:"role_name"`
+	Inherit   sql.NullBool   `db:"inherit"`
+	Login     sql.NullBool   `db:"login"`
+	Superuser sql.NullBool   `db:"superuser"`
+	Comment   sql.NullString `db:"comment"`
 }
 
 var roleQuery = NewBaseQuery(`
@@ -74,6 +116,8 @@ var roleQuery = NewBaseQuery(`
 		mz_roles.id,
 		mz_roles.name AS role_name,
 		mz_roles.inherit,
+		mz_roles.rolcanlogin AS login,
+		mz_roles.rolsuper AS superuser,
 		comments.comment AS comment
 	FROM mz_roles
 	LEFT JOIN (
@@ -0,0 +1,76 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type RoleParameterBuilder struct {
+	ddl          Builder
+	role         MaterializeRole
+	variableName string
+}
+
+func NewRoleParameterBuilder(conn *sqlx.DB, role, variableName string) *RoleParameterBuilder {
+	return &RoleParameterBuilder{
+		ddl:          Builder{conn, Role},
+		role:         MaterializeRole{name: role},
+		variableName: variableName,
+	}
+}
+
+// Each element of a list value, such as a search_path of several schemas, is
+// quoted separately so the session variable is set to a list
+func (b *RoleParameterBuilder) Set(value string) error {
+	var v []string
+	for _, e := range strings.Split(value, ",") {
+		v = append(v, QuoteString(strings.TrimSpace(e)))
+	}
+
+	q := fmt.Sprintf(`ALTER ROLE %s SET %s = %s;`, b.role.QualifiedName(), b.variableName, strings.Join(v, ", "))
+	return b.ddl.exec(q)
+}
+
+func (b *RoleParameterBuilder) Reset() error {
+	q := fmt.Sprintf(`ALTER ROLE %s RESET %s;`, b.role.QualifiedName(), b.variableName)
+	return b.ddl.exec(q)
+}
+
+func (b *RoleParameterBuilder) ParameterKey(region, roleId string) string {
+	return fmt.Sprintf(`%[1]s:ROLE PARAMETER|%[2]s|%[3]s`, region, roleId, b.variableName)
+}
+
+type RoleParameterParams struct {
+	RoleId         sql.NullString `db:"role_id"`
+	RoleName       sql.NullString `db:"role_name"`
+	ParameterName  sql.NullString `db:"parameter_name"`
+	ParameterValue sql.NullString `db:"parameter_value"`
+}
+
+var roleParameterQuery = NewBaseQuery(`
+	SELECT
+		mz_role_parameters.role_id,
+		mz_roles.name AS role_name,
+		mz_role_parameters.parameter_name,
+		mz_role_parameters.parameter_value
+	FROM mz_role_parameters
+	JOIN mz_roles
+		ON mz_role_parameters.role_id = mz_roles.id`)
+
+func ScanRoleParameter(conn *sqlx.DB, roleId, parameterName string) (RoleParameterParams, error) {
+	p := map[string]string{
+		"mz_role_parameters.role_id":        roleId,
+		"mz_role_parameters.parameter_name": parameterName,
+	}
+	q := roleParameterQuery.QueryPredicate(p)
+
+	var c RoleParameterParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,47 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+// https://materialize.com/docs/sql/alter-role/
+
+func TestRoleParameterSet(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER ROLE "role" SET cluster = 'quickstart';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewRoleParameterBuilder(db, "role", "cluster").Set("quickstart"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestRoleParameterSetList(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER ROLE "role" SET search_path = 'public', 'analytics';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewRoleParameterBuilder(db, "role", "search_path").Set("public, analytics"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestRoleParameterReset(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER ROLE "role" RESET statement_timeout;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewRoleParameterBuilder(db, "role", "statement_timeout").Reset(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
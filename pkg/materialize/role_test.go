@@ -26,6 +26,25 @@ func TestRoleCreate(t *testing.T) {
 	})
 }
 
+func TestRoleCreateLogin(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE ROLE "role" INHERIT LOGIN SUPERUSER PASSWORD 'pass''word';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "role"}
+		b := NewRoleBuilder(db, o)
+		b.Inherit()
+		b.Login()
+		b.Superuser()
+		b.Password("pass'word")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestRoleAlter(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -39,6 +58,32 @@ func TestRoleAlter(t *testing.T) {
 	})
 }
 
+func TestRoleAlterPassword(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER ROLE "role" PASSWORD 'password';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "role"}
+		if err := NewRoleBuilder(db, o).AlterPassword("password"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestRoleAlterPasswordNull(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER ROLE "role" PASSWORD NULL;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "role"}
+		if err := NewRoleBuilder(db, o).AlterPassword(""); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestRoleDrop(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
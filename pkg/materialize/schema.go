@@ -37,6 +37,13 @@ func (b *SchemaBuilder) Drop() error {
 	return b.ddl.drop(qn)
 }
 
+// Swap atomically exchanges the names of the schema and another schema in the
+// same database.
+func (b *SchemaBuilder) Swap(target string) error {
+	q := fmt.Sprintf(`ALTER SCHEMA %s SWAP WITH %s;`, b.QualifiedName(), QuoteIdentifier(target))
+	return b.ddl.exec(q)
+}
+
 // DML
 type SchemaParams struct {
 	SchemaId     sql.NullString `db:"id"`
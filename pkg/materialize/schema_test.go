@@ -35,3 +35,16 @@ func TestSchemaDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestSchemaSwap(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SCHEMA "database"."schema" SWAP WITH "schema_green";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "schema", DatabaseName: "database"}
+		if err := NewSchemaBuilder(db, o).Swap("schema_green"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
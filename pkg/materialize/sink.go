@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -35,6 +36,11 @@ func (b *Sink) Resize(newSize string) error {
 	return b.ddl.resize(b.QualifiedName(), newSize)
 }
 
+func (b *Sink) AlterFrom(from IdentifierSchemaStruct) error {
+	q := fmt.Sprintf(`ALTER SINK %s SET FROM %s;`, b.QualifiedName(), from.QualifiedName())
+	return b.ddl.exec(q)
+}
+
 func (b *Sink) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -50,8 +56,11 @@ type SinkParams struct {
 	EnvelopeType   sql.NullString `db:"envelope_type"`
 	ConnectionName sql.NullString `db:"connection_name"`
 	ClusterName    sql.NullString `db:"cluster_name"`
+	Topic          sql.NullString `db:"topic"`
 	Comment        sql.NullString `db:"comment"`
 	OwnerName      sql.NullString `db:"owner_name"`
+	Status         sql.NullString `db:"status"`
+	Error          sql.NullString `db:"error"`
 }
 
 var sinkQuery = NewBaseQuery(`
@@ -65,8 +74,11 @@ var sinkQuery = NewBaseQuery(`
 		mz_sinks.envelope_type,
 		mz_connections.name as connection_name,
 		mz_clusters.name as cluster_name,
+		mz_kafka_sinks.topic,
 		comments.comment AS comment,
-		mz_roles.name AS owner_name
+		mz_roles.name AS owner_name,
+		mz_sink_statuses.status,
+		mz_sink_statuses.error
 	FROM mz_sinks
 	JOIN mz_schemas
 		ON mz_sinks.schema_id = mz_schemas.id
@@ -76,6 +88,8 @@ var sinkQuery = NewBaseQuery(`
 		ON mz_sinks.connection_id = mz_connections.id
 	LEFT JOIN mz_clusters
 		ON mz_sinks.cluster_id = mz_clusters.id
+	LEFT JOIN mz_kafka_sinks
+		ON mz_sinks.id = mz_kafka_sinks.id
 	JOIN mz_roles
 		ON mz_sinks.owner_id = mz_roles.id
 	LEFT JOIN (
@@ -83,7 +97,9 @@ var sinkQuery = NewBaseQuery(`
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'sink'
 	) comments
-		ON mz_sinks.id = comments.id`)
+		ON mz_sinks.id = comments.id
+	LEFT JOIN mz_internal.mz_sink_statuses
+		ON mz_sinks.id = mz_sink_statuses.id`)
 
 func SinkId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
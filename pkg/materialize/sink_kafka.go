@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -25,16 +26,26 @@ func GetSinkKafkaEnelopeStruct(v interface{}) KafkaSinkEnvelopeStruct {
 
 type SinkKafkaBuilder struct {
 	Sink
-	clusterName     string
-	size            string
-	from            IdentifierSchemaStruct
-	kafkaConnection IdentifierSchemaStruct
-	topic           string
-	key             []string
-	format          SinkFormatSpecStruct
-	envelope        KafkaSinkEnvelopeStruct
-	snapshot        bool
-	keyNotEnforced  bool
+	clusterName            string
+	size                   string
+	from                   IdentifierSchemaStruct
+	kafkaConnection        IdentifierSchemaStruct
+	topic                  string
+	compressionType        string
+	partitionBy            string
+	progressGroupIdPrefix  string
+	transactionalIdPrefix  string
+	topicConfig            map[string]string
+	topicPartitionCount    int
+	topicReplicationFactor int
+	key                    []string
+	headers                string
+	format                 SinkFormatSpecStruct
+	keyFormat              SinkFormatSpecStruct
+	valueFormat            SinkFormatSpecStruct
+	envelope               KafkaSinkEnvelopeStruct
+	snapshot               bool
+	keyNotEnforced         bool
 }
 
 func NewSinkKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SinkKafkaBuilder {
@@ -69,6 +80,56 @@ func (b *SinkKafkaBuilder) Topic(t string) *SinkKafkaBuilder {
 	return b
 }
 
+func (b *SinkKafkaBuilder) CompressionType(c string) *SinkKafkaBuilder {
+	b.compressionType = c
+	return b
+}
+
+func (b *SinkKafkaBuilder) PartitionBy(p string) *SinkKafkaBuilder {
+	b.partitionBy = p
+	return b
+}
+
+func (b *SinkKafkaBuilder) ProgressGroupIdPrefix(p string) *SinkKafkaBuilder {
+	b.progressGroupIdPrefix = p
+	return b
+}
+
+func (b *SinkKafkaBuilder) TransactionalIdPrefix(p string) *SinkKafkaBuilder {
+	b.transactionalIdPrefix = p
+	return b
+}
+
+func (b *SinkKafkaBuilder) TopicConfig(c map[string]string) *SinkKafkaBuilder {
+	b.topicConfig = c
+	return b
+}
+
+func (b *SinkKafkaBuilder) TopicPartitionCount(c int) *SinkKafkaBuilder {
+	b.topicPartitionCount = c
+	return b
+}
+
+func (b *SinkKafkaBuilder) TopicReplicationFactor(r int) *SinkKafkaBuilder {
+	b.topicReplicationFactor = r
+	return b
+}
+
+func (b *SinkKafkaBuilder) Headers(h string) *SinkKafkaBuilder {
+	b.headers = h
+	return b
+}
+
+func (b *SinkKafkaBuilder) KeyFormat(k SinkFormatSpecStruct) *SinkKafkaBuilder {
+	b.keyFormat = k
+	return b
+}
+
+func (b *SinkKafkaBuilder) ValueFormat(v SinkFormatSpecStruct) *SinkKafkaBuilder {
+	b.valueFormat = v
+	return b
+}
+
 func (b *SinkKafkaBuilder) Key(k []string) *SinkKafkaBuilder {
 	b.key = k
 	return b
@@ -109,8 +170,52 @@ func (b *SinkKafkaBuilder) Create() error {
 		q.WriteString(fmt.Sprintf(` INTO KAFKA CONNECTION %s`, b.kafkaConnection.QualifiedName()))
 	}
 
+	// Connection Options
+	var c []string
 	if b.topic != "" {
-		q.WriteString(fmt.Sprintf(` (TOPIC %s)`, QuoteString(b.topic)))
+		c = append(c, fmt.Sprintf(`TOPIC %s`, QuoteString(b.topic)))
+	}
+
+	if b.compressionType != "" {
+		c = append(c, fmt.Sprintf(`COMPRESSION TYPE = %s`, QuoteString(b.compressionType)))
+	}
+
+	if b.partitionBy != "" {
+		c = append(c, fmt.Sprintf(`PARTITION BY = %s`, b.partitionBy))
+	}
+
+	if b.progressGroupIdPrefix != "" {
+		c = append(c, fmt.Sprintf(`PROGRESS GROUP ID PREFIX = %s`, QuoteString(b.progressGroupIdPrefix)))
+	}
+
+	if b.transactionalIdPrefix != "" {
+		c = append(c, fmt.Sprintf(`TRANSACTIONAL ID PREFIX = %s`, QuoteString(b.transactionalIdPrefix)))
+	}
+
+	if len(b.topicConfig) > 0 {
+		var keys []string
+		for k := range b.topicConfig {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var m []string
+		for _, k := range keys {
+			m = append(m, fmt.Sprintf(`%s => %s`, QuoteString(k), QuoteString(b.topicConfig[k])))
+		}
+		c = append(c, fmt.Sprintf(`TOPIC CONFIG MAP[%s]`, strings.Join(m, ", ")))
+	}
+
+	if b.topicPartitionCount > 0 {
+		c = append(c, fmt.Sprintf(`TOPIC PARTITION COUNT = %d`, b.topicPartitionCount))
+	}
+
+	if b.topicReplicationFactor > 0 {
+		c = append(c, fmt.Sprintf(`TOPIC REPLICATION FACTOR = %d`, b.topicReplicationFactor))
+	}
+
+	if len(c) > 0 {
+		q.WriteString(fmt.Sprintf(` (%s)`, strings.Join(c, ", ")))
 	}
 
 	if len(b.key) > 0 {
@@ -122,49 +227,90 @@ func (b *SinkKafkaBuilder) Create() error {
 		q.WriteString(` NOT ENFORCED`)
 	}
 
-	if b.format.Json {
-		q.WriteString(` FORMAT JSON`)
+	if b.headers != "" {
+		q.WriteString(fmt.Sprintf(` HEADERS %s`, QuoteIdentifier(b.headers)))
+	}
+
+	q.WriteString(b.formatSpec(`FORMAT`, b.format))
+	q.WriteString(b.formatSpec(`KEY FORMAT`, b.keyFormat))
+	q.WriteString(b.formatSpec(`VALUE FORMAT`, b.valueFormat))
+
+	if b.envelope.Debezium {
+		q.WriteString(` ENVELOPE DEBEZIUM`)
+	} else if b.envelope.Upsert {
+		q.WriteString(` ENVELOPE UPSERT`)
+	}
+
+	// With Options
+	withOptions := []string{}
+	if b.size != "" {
+		withOptions = append(withOptions, fmt.Sprintf(`SIZE = %s`, QuoteString(b.size)))
+	}
+	if b.snapshot {
+		withOptions = append(withOptions, "SNAPSHOT = true")
+	}
+
+	if len(withOptions) > 0 {
+		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(withOptions, ", ")))
+	}
+
+	return b.ddl.exec(q.String())
+}
+
+func (b *SinkKafkaBuilder) formatSpec(prefix string, f SinkFormatSpecStruct) string {
+	q := strings.Builder{}
+
+	if f.Json {
+		q.WriteString(fmt.Sprintf(` %s JSON`, prefix))
+	}
+
+	if f.Text {
+		q.WriteString(fmt.Sprintf(` %s TEXT`, prefix))
 	}
 
-	if b.format.Avro != nil {
-		if b.format.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.format.Avro.SchemaRegistryConnection.QualifiedName()))
+	if f.Bytes {
+		q.WriteString(fmt.Sprintf(` %s BYTES`, prefix))
+	}
+
+	if f.Avro != nil {
+		if f.Avro.SchemaRegistryConnection.Name != "" {
+			q.WriteString(fmt.Sprintf(` %s AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, prefix, f.Avro.SchemaRegistryConnection.QualifiedName()))
 		}
 
 		// CSR Connection Options
 		var v = []string{}
-		if b.format.Avro.AvroValueFullname != "" && b.format.Avro.AvroKeyFullname != "" {
+		if f.Avro.AvroValueFullname != "" && f.Avro.AvroKeyFullname != "" {
 			v = append(v, fmt.Sprintf(`AVRO KEY FULLNAME %s AVRO VALUE FULLNAME %s`,
-				QuoteString(b.format.Avro.AvroKeyFullname),
-				QuoteString(b.format.Avro.AvroValueFullname)),
+				QuoteString(f.Avro.AvroKeyFullname),
+				QuoteString(f.Avro.AvroValueFullname)),
 			)
 		}
 
 		// Doc Type
-		if b.format.Avro.DocType.Object.Name != "" {
+		if f.Avro.DocType.Object.Name != "" {
 			c := strings.Builder{}
-			if b.format.Avro.DocType.Key {
+			if f.Avro.DocType.Key {
 				c.WriteString("KEY ")
-			} else if b.format.Avro.DocType.Value {
+			} else if f.Avro.DocType.Value {
 				c.WriteString("VALUE ")
 			}
 			c.WriteString(fmt.Sprintf("DOC ON TYPE %[1]s = %[2]s",
-				b.format.Avro.DocType.Object.QualifiedName(),
-				QuoteString(b.format.Avro.DocType.Doc),
+				f.Avro.DocType.Object.QualifiedName(),
+				QuoteString(f.Avro.DocType.Doc),
 			))
 			v = append(v, c.String())
 		}
 
 		// Doc Column
-		for _, ac := range b.format.Avro.DocColumn {
+		for _, ac := range f.Avro.DocColumn {
 			c := strings.Builder{}
 			if ac.Key {
 				c.WriteString("KEY")
 			} else if ac.Value {
 				c.WriteString("VALUE")
 			}
-			f := b.from.QualifiedName() + "." + QuoteIdentifier(ac.Column)
-			c.WriteString(fmt.Sprintf(" DOC ON COLUMN %[1]s = %[2]s", f, QuoteString(ac.Doc)))
+			col := b.from.QualifiedName() + "." + QuoteIdentifier(ac.Column)
+			c.WriteString(fmt.Sprintf(" DOC ON COLUMN %[1]s = %[2]s", col, QuoteString(ac.Doc)))
 			v = append(v, c.String())
 		}
 		if len(v) > 0 {
@@ -172,24 +318,5 @@ func (b *SinkKafkaBuilder) Create() error {
 		}
 	}
 
-	if b.envelope.Debezium {
-		q.WriteString(` ENVELOPE DEBEZIUM`)
-	} else if b.envelope.Upsert {
-		q.WriteString(` ENVELOPE UPSERT`)
-	}
-
-	// With Options
-	withOptions := []string{}
-	if b.size != "" {
-		withOptions = append(withOptions, fmt.Sprintf(`SIZE = %s`, QuoteString(b.size)))
-	}
-	if b.snapshot {
-		withOptions = append(withOptions, "SNAPSHOT = true")
-	}
-
-	if len(withOptions) > 0 {
-		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(withOptions, ", ")))
-	}
-
-	return b.ddl.exec(q.String())
+	return q.String()
 }
@@ -359,3 +359,81 @@ func TestSinkKafkaAvroDocsCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestSinkKafkaTopicOptionsCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink"
+			FROM "database"."schema"."src"
+			INTO KAFKA CONNECTION "database"."schema"."kafka_conn"
+			\(TOPIC 'topic', COMPRESSION TYPE = 'gzip', PARTITION BY = seahash\(id::text\),
+			PROGRESS GROUP ID PREFIX = 'progress', TRANSACTIONAL ID PREFIX = 'transaction',
+			TOPIC CONFIG MAP\['cleanup.policy' => 'compact', 'retention.ms' => '1000'\],
+			TOPIC PARTITION COUNT = 6, TOPIC REPLICATION FACTOR = 3\)
+			KEY \(id\) HEADERS "headers"
+			FORMAT JSON ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSinkKafkaBuilder(db, o)
+		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
+		b.Topic("topic")
+		b.CompressionType("gzip")
+		b.PartitionBy("seahash(id::text)")
+		b.ProgressGroupIdPrefix("progress")
+		b.TransactionalIdPrefix("transaction")
+		b.TopicConfig(map[string]string{"retention.ms": "1000", "cleanup.policy": "compact"})
+		b.TopicPartitionCount(6)
+		b.TopicReplicationFactor(3)
+		b.Key([]string{"id"})
+		b.Headers("headers")
+		b.Format(SinkFormatSpecStruct{Json: true})
+		b.Envelope(KafkaSinkEnvelopeStruct{Upsert: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkKafkaKeyValueFormatCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink"
+			FROM "database"."schema"."src"
+			INTO KAFKA CONNECTION "database"."schema"."kafka_conn"
+			\(TOPIC 'topic'\)
+			KEY \(id\)
+			KEY FORMAT TEXT VALUE FORMAT JSON
+			ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSinkKafkaBuilder(db, o)
+		b.From(IdentifierSchemaStruct{Name: "src", SchemaName: "schema", DatabaseName: "database"})
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"})
+		b.Topic("topic")
+		b.Key([]string{"id"})
+		b.KeyFormat(SinkFormatSpecStruct{Text: true})
+		b.ValueFormat(SinkFormatSpecStruct{Json: true})
+		b.Envelope(KafkaSinkEnvelopeStruct{Upsert: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSinkAlterFrom(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SINK "database"."schema"."sink" SET FROM "database"."schema"."src_v2";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "sink", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewSink(db, o).AlterFrom(IdentifierSchemaStruct{Name: "src_v2", SchemaName: "schema", DatabaseName: "database"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -9,22 +9,55 @@ import (
 )
 
 type TableStruct struct {
-	Name  string
-	Alias string
+	Name           string
+	Alias          string
+	TextColumns    []string
+	ExcludeColumns []string
+}
+
+func getTableStruct(t map[string]interface{}) TableStruct {
+	table := TableStruct{
+		Name:  t["name"].(string),
+		Alias: t["alias"].(string),
+	}
+	if v, ok := t["text_columns"]; ok {
+		table.TextColumns = GetSliceValueString(v.([]interface{}))
+	}
+	if v, ok := t["exclude_columns"]; ok {
+		table.ExcludeColumns = GetSliceValueString(v.([]interface{}))
+	}
+	return table
 }
 
 func GetTableStruct(v []interface{}) []TableStruct {
 	var tables []TableStruct
 	for _, table := range v {
-		t := table.(map[string]interface{})
-		tables = append(tables, TableStruct{
-			Name:  t["name"].(string),
-			Alias: t["alias"].(string),
-		})
+		tables = append(tables, getTableStruct(table.(map[string]interface{})))
 	}
 	return tables
 }
 
+// Tables present in both lists whose alias, text or excluded columns differ, as they appear in arr1
+func ChangedTableStructs(arr1, arr2 []interface{}) []TableStruct {
+	var changed []TableStruct
+
+	for _, item1 := range arr1 {
+		for _, item2 := range arr2 {
+			if !areEqual(item1, item2) {
+				continue
+			}
+			t1 := getTableStruct(item1.(map[string]interface{}))
+			t2 := getTableStruct(item2.(map[string]interface{}))
+			if t1.Alias != t2.Alias || !reflect.DeepEqual(t1.TextColumns, t2.TextColumns) || !reflect.DeepEqual(t1.ExcludeColumns, t2.ExcludeColumns) {
+				changed = append(changed, t1)
+			}
+			break
+		}
+	}
+
+	return changed
+}
+
 func DiffTableStructs(arr1, arr2 []interface{}) []TableStruct {
 	var difference []TableStruct
 
@@ -38,10 +71,7 @@ func DiffTableStructs(arr1, arr2 []interface{}) []TableStruct {
 		}
 		if !found {
 			if diffItem, ok := item1.(map[string]interface{}); ok {
-				difference = append(difference, TableStruct{
-					Name:  diffItem["name"].(string),
-					Alias: diffItem["alias"].(string),
-				})
+				difference = append(difference, getTableStruct(diffItem))
 			}
 		}
 	}
@@ -111,6 +141,8 @@ type SourceParams struct {
 	Comment        sql.NullString `db:"comment"`
 	OwnerName      sql.NullString `db:"owner_name"`
 	Privileges     pq.StringArray `db:"privileges"`
+	Status         sql.NullString `db:"status"`
+	Error          sql.NullString `db:"error"`
 }
 
 var sourceQuery = NewBaseQuery(`
@@ -126,7 +158,9 @@ var sourceQuery = NewBaseQuery(`
 			mz_clusters.name as cluster_name,
 			comments.comment AS comment,
 			mz_roles.name AS owner_name,
-			mz_sources.privileges
+			mz_sources.privileges,
+			mz_source_statuses.status,
+			mz_source_statuses.error
 		FROM mz_sources
 		JOIN mz_schemas
 			ON mz_sources.schema_id = mz_schemas.id
@@ -143,7 +177,9 @@ var sourceQuery = NewBaseQuery(`
 			FROM mz_internal.mz_comments
 			WHERE object_type = 'source'
 		) comments
-			ON mz_sources.id = comments.id`)
+			ON mz_sources.id = comments.id
+		LEFT JOIN mz_internal.mz_source_statuses
+			ON mz_sources.id = mz_source_statuses.id`)
 
 func SourceId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
 	p := map[string]string{
@@ -186,3 +222,20 @@ func ListSources(conn *sqlx.DB, schemaName, databaseName string) ([]SourceParams
 
 	return c, nil
 }
+
+var sourceSnapshotQuery = NewBaseQuery(`
+		SELECT bool_and(mz_source_statistics.snapshot_committed) AS snapshot_committed
+		FROM mz_internal.mz_source_statistics`)
+
+// SourceSnapshotCommitted reports whether the source has committed its initial
+// snapshot. Sources without statistics have not.
+func SourceSnapshotCommitted(conn *sqlx.DB, id string) (bool, error) {
+	q := sourceSnapshotQuery.QueryPredicate(map[string]string{"mz_source_statistics.id": id})
+
+	var c sql.NullBool
+	if err := conn.Get(&c, q); err != nil {
+		return false, err
+	}
+
+	return c.Bool, nil
+}
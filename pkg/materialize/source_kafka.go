@@ -8,10 +8,42 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+type InlineDecodingErrorsStruct struct {
+	Enabled bool
+	Alias   string
+}
+
+type ValueDecodingErrorsStruct struct {
+	Inline InlineDecodingErrorsStruct
+}
+
+type UpsertOptionsStruct struct {
+	ValueDecodingErrors ValueDecodingErrorsStruct
+}
+
 type KafkaSourceEnvelopeStruct struct {
-	Debezium bool
-	None     bool
-	Upsert   bool
+	Debezium      bool
+	None          bool
+	Upsert        bool
+	UpsertOptions *UpsertOptionsStruct
+}
+
+func getUpsertOptionsStruct(v interface{}) *UpsertOptionsStruct {
+	var options UpsertOptionsStruct
+	u := v.([]interface{})[0].(map[string]interface{})
+	if e, ok := u["value_decoding_errors"]; ok && len(e.([]interface{})) > 0 && e.([]interface{})[0] != nil {
+		errors := e.([]interface{})[0].(map[string]interface{})
+		if i, ok := errors["inline"]; ok && len(i.([]interface{})) > 0 && i.([]interface{})[0] != nil {
+			inline := i.([]interface{})[0].(map[string]interface{})
+			if v, ok := inline["enabled"]; ok {
+				options.ValueDecodingErrors.Inline.Enabled = v.(bool)
+			}
+			if v, ok := inline["alias"]; ok {
+				options.ValueDecodingErrors.Inline.Alias = v.(string)
+			}
+		}
+	}
+	return &options
 }
 
 func GetSourceKafkaEnelopeStruct(v interface{}) KafkaSourceEnvelopeStruct {
@@ -25,15 +57,14 @@ func GetSourceKafkaEnelopeStruct(v interface{}) KafkaSourceEnvelopeStruct {
 	if v, ok := v.([]interface{})[0].(map[string]interface{})["none"]; ok {
 		envelope.None = v.(bool)
 	}
+	if v, ok := v.([]interface{})[0].(map[string]interface{})["upsert_options"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		envelope.UpsertOptions = getUpsertOptionsStruct(v)
+	}
 	return envelope
 }
 
-type SourceKafkaBuilder struct {
-	Source
-	clusterName      string
-	size             string
-	kafkaConnection  IdentifierSchemaStruct
-	topic            string
+// Format, metadata and envelope shared by Kafka sources and tables created from Kafka sources
+type kafkaSourceSpec struct {
 	includeKey       bool
 	includeHeaders   bool
 	includePartition bool
@@ -48,155 +79,48 @@ type SourceKafkaBuilder struct {
 	keyFormat        SourceFormatSpecStruct
 	valueFormat      SourceFormatSpecStruct
 	envelope         KafkaSourceEnvelopeStruct
-	startOffset      []int
-	startTimestamp   int
-	exposeProgress   IdentifierSchemaStruct
-}
-
-func NewSourceKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceKafkaBuilder {
-	b := Builder{conn, BaseSink}
-	return &SourceKafkaBuilder{
-		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
-	}
-}
-
-func (b *SourceKafkaBuilder) ClusterName(c string) *SourceKafkaBuilder {
-	b.clusterName = c
-	return b
-}
-
-func (b *SourceKafkaBuilder) Size(s string) *SourceKafkaBuilder {
-	b.size = s
-	return b
-}
-
-func (b *SourceKafkaBuilder) KafkaConnection(k IdentifierSchemaStruct) *SourceKafkaBuilder {
-	b.kafkaConnection = k
-	return b
-}
-
-func (b *SourceKafkaBuilder) Topic(t string) *SourceKafkaBuilder {
-	b.topic = t
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeKey() *SourceKafkaBuilder {
-	b.includeKey = true
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeHeaders() *SourceKafkaBuilder {
-	b.includeHeaders = true
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludePartition() *SourceKafkaBuilder {
-	b.includePartition = true
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeOffset() *SourceKafkaBuilder {
-	b.includeOffset = true
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeTimestamp() *SourceKafkaBuilder {
-	b.includeTimestamp = true
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeKeyAlias(alias string) *SourceKafkaBuilder {
-	b.includeKey = true
-	b.keyAlias = alias
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeHeadersAlias(alias string) *SourceKafkaBuilder {
-	b.includeHeaders = true
-	b.headersAlias = alias
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludePartitionAlias(alias string) *SourceKafkaBuilder {
-	b.includePartition = true
-	b.partitionAlias = alias
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeOffsetAlias(alias string) *SourceKafkaBuilder {
-	b.includeOffset = true
-	b.offsetAlias = alias
-	return b
-}
-
-func (b *SourceKafkaBuilder) IncludeTimestampAlias(alias string) *SourceKafkaBuilder {
-	b.includeTimestamp = true
-	b.timestampAlias = alias
-	return b
 }
 
-func (b *SourceKafkaBuilder) Format(f SourceFormatSpecStruct) *SourceKafkaBuilder {
-	b.format = f
-	return b
+func (f SourceFormatSpecStruct) isSet() bool {
+	return f.Avro != nil || f.Protobuf != nil || f.Csv != nil || f.Bytes || f.Text || f.Json
 }
 
-func (b *SourceKafkaBuilder) Envelope(e KafkaSourceEnvelopeStruct) *SourceKafkaBuilder {
-	b.envelope = e
-	return b
-}
+// Ensure the envelope can be applied to the chosen format
+func (b *kafkaSourceSpec) validate() error {
+	if b.keyFormat.isSet() != b.valueFormat.isSet() {
+		return fmt.Errorf("key_format and value_format must be set together")
+	}
 
-func (b *SourceKafkaBuilder) KeyFormat(k SourceFormatSpecStruct) *SourceKafkaBuilder {
-	b.keyFormat = k
-	return b
-}
+	if b.envelope.Debezium && b.format.Avro == nil && b.valueFormat.Avro == nil {
+		return fmt.Errorf("envelope debezium requires an avro format")
+	}
 
-func (b *SourceKafkaBuilder) ValueFormat(v SourceFormatSpecStruct) *SourceKafkaBuilder {
-	b.valueFormat = v
-	return b
-}
+	if o := b.envelope.UpsertOptions; o != nil {
+		if !b.envelope.Upsert && o.ValueDecodingErrors.Inline.Enabled {
+			return fmt.Errorf("upsert_options is set but envelope upsert is false")
+		}
 
-func (b *SourceKafkaBuilder) StartOffset(s []int) *SourceKafkaBuilder {
-	b.startOffset = s
-	return b
-}
+		if !o.ValueDecodingErrors.Inline.Enabled && o.ValueDecodingErrors.Inline.Alias != "" {
+			return fmt.Errorf("inline decoding errors alias is set but inline decoding errors are not enabled")
+		}
+	}
 
-func (b *SourceKafkaBuilder) StartTimestamp(s int) *SourceKafkaBuilder {
-	b.startTimestamp = s
-	return b
+	return nil
 }
 
-func (b *SourceKafkaBuilder) ExposeProgress(e IdentifierSchemaStruct) *SourceKafkaBuilder {
-	b.exposeProgress = e
-	return b
+// ValidateKafkaSourceFormat ensures the envelope can be applied to the chosen
+// formats, so invalid combinations are reported before any statement is run
+func ValidateKafkaSourceFormat(format, keyFormat, valueFormat SourceFormatSpecStruct, envelope KafkaSourceEnvelopeStruct) error {
+	spec := kafkaSourceSpec{format: format, keyFormat: keyFormat, valueFormat: valueFormat, envelope: envelope}
+	return spec.validate()
 }
 
-func (b *SourceKafkaBuilder) Create() error {
-	q := strings.Builder{}
-	q.WriteString(fmt.Sprintf(`CREATE SOURCE %s`, b.QualifiedName()))
-
-	if b.clusterName != "" {
-		q.WriteString(fmt.Sprintf(` IN CLUSTER %s`, QuoteIdentifier(b.clusterName)))
-	}
-
-	q.WriteString(fmt.Sprintf(` FROM KAFKA CONNECTION %s`, b.kafkaConnection.QualifiedName()))
-	q.WriteString(fmt.Sprintf(` (TOPIC %s`, QuoteString(b.topic)))
-
-	// Time-based Offsets
-	if b.startTimestamp != 0 {
-		q.WriteString(fmt.Sprintf(`, START TIMESTAMP %d`, b.startTimestamp))
-	}
-	if len(b.startOffset) > 0 {
-		o := ""
-		for _, v := range b.startOffset {
-			if len(o) > 0 {
-				o += ","
-			}
-			o += strconv.Itoa((v))
-		}
-		q.WriteString(fmt.Sprintf(`, START OFFSET (%s)`, o))
+func (b *kafkaSourceSpec) clauses() (string, error) {
+	if err := b.validate(); err != nil {
+		return "", err
 	}
 
-	q.WriteString(`)`)
+	q := strings.Builder{}
 
 	// Format
 	if b.format.Avro != nil {
@@ -339,7 +263,7 @@ func (b *SourceKafkaBuilder) Create() error {
 	var i []string
 
 	if !b.includeKey && b.keyAlias != "" {
-		return fmt.Errorf("include_key_alias is set but include_key is false")
+		return "", fmt.Errorf("include_key_alias is set but include_key is false")
 	}
 
 	if b.includeKey {
@@ -351,7 +275,7 @@ func (b *SourceKafkaBuilder) Create() error {
 	}
 
 	if !b.includeHeaders && b.headersAlias != "" {
-		return fmt.Errorf("include_headers_alias is set but include_headers is false")
+		return "", fmt.Errorf("include_headers_alias is set but include_headers is false")
 	}
 
 	if b.includeHeaders {
@@ -363,7 +287,7 @@ func (b *SourceKafkaBuilder) Create() error {
 	}
 
 	if !b.includePartition && b.partitionAlias != "" {
-		return fmt.Errorf("include_partition_alias is set but include_partition is false")
+		return "", fmt.Errorf("include_partition_alias is set but include_partition is false")
 	}
 
 	if b.includePartition {
@@ -375,7 +299,7 @@ func (b *SourceKafkaBuilder) Create() error {
 	}
 
 	if !b.includeOffset && b.offsetAlias != "" {
-		return fmt.Errorf("include_offset_alias is set but include_offset is false")
+		return "", fmt.Errorf("include_offset_alias is set but include_offset is false")
 	}
 
 	if b.includeOffset {
@@ -387,7 +311,7 @@ func (b *SourceKafkaBuilder) Create() error {
 	}
 
 	if !b.includeTimestamp && b.timestampAlias != "" {
-		return fmt.Errorf("include_timestamp_alias is set but include_timestamp is false")
+		return "", fmt.Errorf("include_timestamp_alias is set but include_timestamp is false")
 	}
 
 	if b.includeTimestamp {
@@ -409,12 +333,206 @@ func (b *SourceKafkaBuilder) Create() error {
 
 	if b.envelope.Upsert {
 		q.WriteString(` ENVELOPE UPSERT`)
+
+		if o := b.envelope.UpsertOptions; o != nil && o.ValueDecodingErrors.Inline.Enabled {
+			if o.ValueDecodingErrors.Inline.Alias != "" {
+				q.WriteString(fmt.Sprintf(` (VALUE DECODING ERRORS = (INLINE AS %s))`, QuoteIdentifier(o.ValueDecodingErrors.Inline.Alias)))
+			} else {
+				q.WriteString(` (VALUE DECODING ERRORS = INLINE)`)
+			}
+		}
 	}
 
 	if b.envelope.None {
 		q.WriteString(` ENVELOPE NONE`)
 	}
 
+	return q.String(), nil
+}
+
+type SourceKafkaBuilder struct {
+	Source
+	clusterName     string
+	size            string
+	kafkaConnection IdentifierSchemaStruct
+	topic           string
+	kafkaSourceSpec
+	groupIdPrefix                string
+	startOffset                  []int
+	startTimestamp               int
+	topicMetadataRefreshInterval string
+	exposeProgress               IdentifierSchemaStruct
+}
+
+func NewSourceKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceKafkaBuilder {
+	b := Builder{conn, BaseSink}
+	return &SourceKafkaBuilder{
+		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+	}
+}
+
+func (b *SourceKafkaBuilder) ClusterName(c string) *SourceKafkaBuilder {
+	b.clusterName = c
+	return b
+}
+
+func (b *SourceKafkaBuilder) Size(s string) *SourceKafkaBuilder {
+	b.size = s
+	return b
+}
+
+func (b *SourceKafkaBuilder) KafkaConnection(k IdentifierSchemaStruct) *SourceKafkaBuilder {
+	b.kafkaConnection = k
+	return b
+}
+
+func (b *SourceKafkaBuilder) Topic(t string) *SourceKafkaBuilder {
+	b.topic = t
+	return b
+}
+
+func (b *SourceKafkaBuilder) GroupIdPrefix(g string) *SourceKafkaBuilder {
+	b.groupIdPrefix = g
+	return b
+}
+
+func (b *SourceKafkaBuilder) TopicMetadataRefreshInterval(t string) *SourceKafkaBuilder {
+	b.topicMetadataRefreshInterval = t
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeKey() *SourceKafkaBuilder {
+	b.includeKey = true
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeHeaders() *SourceKafkaBuilder {
+	b.includeHeaders = true
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludePartition() *SourceKafkaBuilder {
+	b.includePartition = true
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeOffset() *SourceKafkaBuilder {
+	b.includeOffset = true
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeTimestamp() *SourceKafkaBuilder {
+	b.includeTimestamp = true
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeKeyAlias(alias string) *SourceKafkaBuilder {
+	b.includeKey = true
+	b.keyAlias = alias
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeHeadersAlias(alias string) *SourceKafkaBuilder {
+	b.includeHeaders = true
+	b.headersAlias = alias
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludePartitionAlias(alias string) *SourceKafkaBuilder {
+	b.includePartition = true
+	b.partitionAlias = alias
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeOffsetAlias(alias string) *SourceKafkaBuilder {
+	b.includeOffset = true
+	b.offsetAlias = alias
+	return b
+}
+
+func (b *SourceKafkaBuilder) IncludeTimestampAlias(alias string) *SourceKafkaBuilder {
+	b.includeTimestamp = true
+	b.timestampAlias = alias
+	return b
+}
+
+func (b *SourceKafkaBuilder) Format(f SourceFormatSpecStruct) *SourceKafkaBuilder {
+	b.format = f
+	return b
+}
+
+func (b *SourceKafkaBuilder) Envelope(e KafkaSourceEnvelopeStruct) *SourceKafkaBuilder {
+	b.envelope = e
+	return b
+}
+
+func (b *SourceKafkaBuilder) KeyFormat(k SourceFormatSpecStruct) *SourceKafkaBuilder {
+	b.keyFormat = k
+	return b
+}
+
+func (b *SourceKafkaBuilder) ValueFormat(v SourceFormatSpecStruct) *SourceKafkaBuilder {
+	b.valueFormat = v
+	return b
+}
+
+func (b *SourceKafkaBuilder) StartOffset(s []int) *SourceKafkaBuilder {
+	b.startOffset = s
+	return b
+}
+
+func (b *SourceKafkaBuilder) StartTimestamp(s int) *SourceKafkaBuilder {
+	b.startTimestamp = s
+	return b
+}
+
+func (b *SourceKafkaBuilder) ExposeProgress(e IdentifierSchemaStruct) *SourceKafkaBuilder {
+	b.exposeProgress = e
+	return b
+}
+
+func (b *SourceKafkaBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE SOURCE %s`, b.QualifiedName()))
+
+	if b.clusterName != "" {
+		q.WriteString(fmt.Sprintf(` IN CLUSTER %s`, QuoteIdentifier(b.clusterName)))
+	}
+
+	q.WriteString(fmt.Sprintf(` FROM KAFKA CONNECTION %s`, b.kafkaConnection.QualifiedName()))
+	q.WriteString(fmt.Sprintf(` (TOPIC %s`, QuoteString(b.topic)))
+
+	if b.groupIdPrefix != "" {
+		q.WriteString(fmt.Sprintf(`, GROUP ID PREFIX %s`, QuoteString(b.groupIdPrefix)))
+	}
+
+	// Time-based Offsets
+	if b.startTimestamp != 0 {
+		q.WriteString(fmt.Sprintf(`, START TIMESTAMP %d`, b.startTimestamp))
+	}
+	if len(b.startOffset) > 0 {
+		o := ""
+		for _, v := range b.startOffset {
+			if len(o) > 0 {
+				o += ","
+			}
+			o += strconv.Itoa((v))
+		}
+		q.WriteString(fmt.Sprintf(`, START OFFSET (%s)`, o))
+	}
+
+	if b.topicMetadataRefreshInterval != "" {
+		q.WriteString(fmt.Sprintf(`, TOPIC METADATA REFRESH INTERVAL %s`, QuoteString(b.topicMetadataRefreshInterval)))
+	}
+
+	q.WriteString(`)`)
+
+	spec, err := b.kafkaSourceSpec.clauses()
+	if err != nil {
+		return err
+	}
+	q.WriteString(spec)
+
 	if b.exposeProgress.Name != "" {
 		q.WriteString(fmt.Sprintf(` EXPOSE PROGRESS AS %s`, b.exposeProgress.QualifiedName()))
 	}
@@ -39,3 +39,184 @@ func TestResourceSourceKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+var sourceKafka = MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+var sourceKafkaConnection = IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"}
+var sourceKafkaAvroFormat = SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}}}
+
+func TestSourceKafkaGroupIdPrefixCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events', GROUP ID PREFIX 'materialize-prefix'\)
+			FORMAT JSON;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceKafkaBuilder(db, sourceKafka)
+		b.KafkaConnection(sourceKafkaConnection)
+		b.Topic("events")
+		b.GroupIdPrefix("materialize-prefix")
+		b.Format(SourceFormatSpecStruct{Json: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceKafkaTopicMetadataRefreshIntervalCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events', GROUP ID PREFIX 'materialize-prefix', START OFFSET \(0,10\), TOPIC METADATA REFRESH INTERVAL '30s'\)
+			FORMAT JSON;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceKafkaBuilder(db, sourceKafka)
+		b.KafkaConnection(sourceKafkaConnection)
+		b.Topic("events")
+		b.GroupIdPrefix("materialize-prefix")
+		b.StartOffset([]int{0, 10})
+		b.TopicMetadataRefreshInterval("30s")
+		b.Format(SourceFormatSpecStruct{Json: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceKafkaUpsertInlineDecodingErrorsCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events'\)
+			FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
+			ENVELOPE UPSERT \(VALUE DECODING ERRORS = INLINE\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceKafkaBuilder(db, sourceKafka)
+		b.KafkaConnection(sourceKafkaConnection)
+		b.Topic("events")
+		b.Format(sourceKafkaAvroFormat)
+		b.Envelope(KafkaSourceEnvelopeStruct{
+			Upsert:        true,
+			UpsertOptions: &UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: InlineDecodingErrorsStruct{Enabled: true}}},
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceKafkaUpsertInlineDecodingErrorsAliasCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events'\)
+			KEY FORMAT TEXT VALUE FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
+			ENVELOPE UPSERT \(VALUE DECODING ERRORS = \(INLINE AS "decoding_error"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceKafkaBuilder(db, sourceKafka)
+		b.KafkaConnection(sourceKafkaConnection)
+		b.Topic("events")
+		b.KeyFormat(SourceFormatSpecStruct{Text: true})
+		b.ValueFormat(sourceKafkaAvroFormat)
+		b.Envelope(KafkaSourceEnvelopeStruct{
+			Upsert:        true,
+			UpsertOptions: &UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: InlineDecodingErrorsStruct{Enabled: true, Alias: "decoding_error"}}},
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceKafkaDebeziumCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events'\)
+			FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
+			ENVELOPE DEBEZIUM;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceKafkaBuilder(db, sourceKafka)
+		b.KafkaConnection(sourceKafkaConnection)
+		b.Topic("events")
+		b.Format(sourceKafkaAvroFormat)
+		b.Envelope(KafkaSourceEnvelopeStruct{Debezium: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceKafkaInvalidEnvelopeCreate(t *testing.T) {
+	inline := &UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: InlineDecodingErrorsStruct{Enabled: true}}}
+
+	cases := []struct {
+		name        string
+		format      SourceFormatSpecStruct
+		keyFormat   SourceFormatSpecStruct
+		valueFormat SourceFormatSpecStruct
+		envelope    KafkaSourceEnvelopeStruct
+		err         string
+	}{
+		{
+			name:     "debezium without avro",
+			format:   SourceFormatSpecStruct{Json: true},
+			envelope: KafkaSourceEnvelopeStruct{Debezium: true},
+			err:      "envelope debezium requires an avro format",
+		},
+		{
+			name:      "key format without value format",
+			keyFormat: SourceFormatSpecStruct{Text: true},
+			envelope:  KafkaSourceEnvelopeStruct{Upsert: true},
+			err:       "key_format and value_format must be set together",
+		},
+		{
+			name:     "inline decoding errors without upsert",
+			format:   SourceFormatSpecStruct{Json: true},
+			envelope: KafkaSourceEnvelopeStruct{None: true, UpsertOptions: inline},
+			err:      "upsert_options is set but envelope upsert is false",
+		},
+		{
+			name:   "inline alias without inline",
+			format: SourceFormatSpecStruct{Json: true},
+			envelope: KafkaSourceEnvelopeStruct{
+				Upsert:        true,
+				UpsertOptions: &UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: InlineDecodingErrorsStruct{Alias: "decoding_error"}}},
+			},
+			err: "inline decoding errors alias is set but inline decoding errors are not enabled",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+				b := NewSourceKafkaBuilder(db, sourceKafka)
+				b.KafkaConnection(sourceKafkaConnection)
+				b.Topic("events")
+				b.Format(c.format)
+				b.KeyFormat(c.keyFormat)
+				b.ValueFormat(c.valueFormat)
+				b.Envelope(c.envelope)
+
+				err := b.Create()
+				if err == nil || err.Error() != c.err {
+					t.Fatalf("expected error %q, got %v", c.err, err)
+				}
+			})
+		})
+	}
+}
@@ -84,6 +84,80 @@ func GetTPCHOptionsStruct(v interface{}) TPCHOptions {
 	return o
 }
 
+type KeyValueOptions struct {
+	Keys                  int
+	SnapshotRounds        int
+	TransactionalSnapshot bool
+	ValueSize             int
+	Seed                  int
+	Partitions            int
+	BatchSize             int
+	TickInterval          string
+}
+
+func GetKeyValueOptionsStruct(v interface{}) KeyValueOptions {
+	var o KeyValueOptions
+	u := v.([]interface{})[0].(map[string]interface{})
+	if v, ok := u["keys"]; ok {
+		o.Keys = v.(int)
+	}
+
+	if v, ok := u["snapshot_rounds"]; ok {
+		o.SnapshotRounds = v.(int)
+	}
+
+	if v, ok := u["transactional_snapshot"]; ok {
+		o.TransactionalSnapshot = v.(bool)
+	}
+
+	if v, ok := u["value_size"]; ok {
+		o.ValueSize = v.(int)
+	}
+
+	if v, ok := u["seed"]; ok {
+		o.Seed = v.(int)
+	}
+
+	if v, ok := u["partitions"]; ok {
+		o.Partitions = v.(int)
+	}
+
+	if v, ok := u["batch_size"]; ok {
+		o.BatchSize = v.(int)
+	}
+
+	if v, ok := u["tick_interval"]; ok {
+		o.TickInterval = v.(string)
+	}
+	return o
+}
+
+type ClockOptions struct {
+	TickInterval string
+}
+
+func GetClockOptionsStruct(v interface{}) ClockOptions {
+	var o ClockOptions
+	u := v.([]interface{})[0].(map[string]interface{})
+	if v, ok := u["tick_interval"]; ok {
+		o.TickInterval = v.(string)
+	}
+	return o
+}
+
+type DatumsOptions struct {
+	TickInterval string
+}
+
+func GetDatumsOptionsStruct(v interface{}) DatumsOptions {
+	var o DatumsOptions
+	u := v.([]interface{})[0].(map[string]interface{})
+	if v, ok := u["tick_interval"]; ok {
+		o.TickInterval = v.(string)
+	}
+	return o
+}
+
 type SourceLoadgenBuilder struct {
 	Source
 	clusterName       string
@@ -93,6 +167,11 @@ type SourceLoadgenBuilder struct {
 	auctionOptions    AuctionOptions
 	marketingOptions  MarketingOptions
 	tpchOptions       TPCHOptions
+	keyValueOptions   KeyValueOptions
+	clockOptions      ClockOptions
+	datumsOptions     DatumsOptions
+	asOf              int
+	upTo              int
 	exposeProgress    IdentifierSchemaStruct
 }
 
@@ -143,6 +222,31 @@ func (b *SourceLoadgenBuilder) TPCHOptions(t TPCHOptions) *SourceLoadgenBuilder
 	return b
 }
 
+func (b *SourceLoadgenBuilder) KeyValueOptions(k KeyValueOptions) *SourceLoadgenBuilder {
+	b.keyValueOptions = k
+	return b
+}
+
+func (b *SourceLoadgenBuilder) ClockOptions(c ClockOptions) *SourceLoadgenBuilder {
+	b.clockOptions = c
+	return b
+}
+
+func (b *SourceLoadgenBuilder) DatumsOptions(d DatumsOptions) *SourceLoadgenBuilder {
+	b.datumsOptions = d
+	return b
+}
+
+func (b *SourceLoadgenBuilder) AsOf(a int) *SourceLoadgenBuilder {
+	b.asOf = a
+	return b
+}
+
+func (b *SourceLoadgenBuilder) UpTo(u int) *SourceLoadgenBuilder {
+	b.upTo = u
+	return b
+}
+
 func (b *SourceLoadgenBuilder) Create() error {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE SOURCE %s`, b.QualifiedName()))
@@ -156,7 +260,7 @@ func (b *SourceLoadgenBuilder) Create() error {
 	// Optional Parameters
 	var p []string
 
-	for _, t := range []string{b.counterOptions.TickInterval, b.auctionOptions.TickInterval, b.marketingOptions.TickInterval, b.tpchOptions.TickInterval} {
+	for _, t := range []string{b.counterOptions.TickInterval, b.auctionOptions.TickInterval, b.marketingOptions.TickInterval, b.tpchOptions.TickInterval, b.keyValueOptions.TickInterval, b.clockOptions.TickInterval, b.datumsOptions.TickInterval} {
 		if t != "" {
 			p = append(p, fmt.Sprintf(`TICK INTERVAL %s`, QuoteString(t)))
 		}
@@ -173,6 +277,25 @@ func (b *SourceLoadgenBuilder) Create() error {
 		p = append(p, s)
 	}
 
+	if b.loadGeneratorType == "KEY VALUE" {
+		k := b.keyValueOptions
+		p = append(p, fmt.Sprintf(`KEYS %d`, k.Keys))
+		p = append(p, fmt.Sprintf(`SNAPSHOT ROUNDS %d`, k.SnapshotRounds))
+		p = append(p, fmt.Sprintf(`TRANSACTIONAL SNAPSHOT %t`, k.TransactionalSnapshot))
+		p = append(p, fmt.Sprintf(`VALUE SIZE %d`, k.ValueSize))
+		p = append(p, fmt.Sprintf(`SEED %d`, k.Seed))
+		p = append(p, fmt.Sprintf(`PARTITIONS %d`, k.Partitions))
+		p = append(p, fmt.Sprintf(`BATCH SIZE %d`, k.BatchSize))
+	}
+
+	if b.asOf != 0 {
+		p = append(p, fmt.Sprintf(`AS OF %d`, b.asOf))
+	}
+
+	if b.upTo != 0 {
+		p = append(p, fmt.Sprintf(`UP TO %d`, b.upTo))
+	}
+
 	if len(p) != 0 {
 		p := strings.Join(p[:], ", ")
 		q.WriteString(fmt.Sprintf(` (%s)`, p))
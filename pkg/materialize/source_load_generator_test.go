@@ -106,3 +106,69 @@ func TestSourceLoadgenTPCHParamsCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestSourceLoadgenKeyValueCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM LOAD GENERATOR KEY VALUE
+			\(TICK INTERVAL '1s', KEYS 128, SNAPSHOT ROUNDS 2, TRANSACTIONAL SNAPSHOT false, VALUE SIZE 64, SEED 42, PARTITIONS 4, BATCH SIZE 8\)
+			WITH \(SIZE = 'xsmall'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b.Size("xsmall")
+		b.LoadGeneratorType("KEY VALUE")
+		b.KeyValueOptions(KeyValueOptions{
+			Keys:           128,
+			SnapshotRounds: 2,
+			ValueSize:      64,
+			Seed:           42,
+			Partitions:     4,
+			BatchSize:      8,
+			TickInterval:   "1s",
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceLoadgenClockCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM LOAD GENERATOR CLOCK
+			\(TICK INTERVAL '1s'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b.LoadGeneratorType("CLOCK")
+		b.ClockOptions(ClockOptions{TickInterval: "1s"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceLoadgenDatumsAsOfUpToCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM LOAD GENERATOR DATUMS
+			\(TICK INTERVAL '500ms', AS OF 10, UP TO 100\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b.LoadGeneratorType("DATUMS")
+		b.DatumsOptions(DatumsOptions{TickInterval: "500ms"})
+		b.AsOf(10)
+		b.UpTo(100)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -77,14 +77,10 @@ func (b *SourcePostgresBuilder) Create() error {
 	q.WriteString(fmt.Sprintf(` FROM POSTGRES CONNECTION %s`, b.postgresConnection.QualifiedName()))
 
 	// Publication
-	p := fmt.Sprintf(`PUBLICATION %s`, QuoteString(b.publication))
+	p := []string{fmt.Sprintf(`PUBLICATION %s`, QuoteString(b.publication))}
+	p = append(p, tableColumnOptions(b.table, b.textColumns, "(%s)")...)
 
-	if len(b.textColumns) > 0 {
-		s := strings.Join(b.textColumns, ", ")
-		p = p + fmt.Sprintf(`, TEXT COLUMNS (%s)`, s)
-	}
-
-	q.WriteString(fmt.Sprintf(` (%s)`, p))
+	q.WriteString(fmt.Sprintf(` (%s)`, strings.Join(p, ", ")))
 
 	if len(b.table) > 0 {
 		q.WriteString(` FOR TABLES (`)
@@ -117,6 +113,34 @@ func (b *SourcePostgresBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+// Text and excluded columns of each table are qualified with the upstream table name.
+// The column lists are wrapped with list, as CREATE SOURCE and ADD SUBSOURCE
+// delimit them differently
+func tableColumnOptions(tables []TableStruct, textColumns []string, list string) []string {
+	var o []string
+
+	t := append([]string{}, textColumns...)
+	var e []string
+	for _, table := range tables {
+		for _, c := range table.TextColumns {
+			t = append(t, fmt.Sprintf("%s.%s", table.Name, c))
+		}
+		for _, c := range table.ExcludeColumns {
+			e = append(e, fmt.Sprintf("%s.%s", table.Name, c))
+		}
+	}
+
+	if len(t) > 0 {
+		o = append(o, `TEXT COLUMNS `+fmt.Sprintf(list, strings.Join(t, ", ")))
+	}
+
+	if len(e) > 0 {
+		o = append(o, `EXCLUDE COLUMNS `+fmt.Sprintf(list, strings.Join(e, ", ")))
+	}
+
+	return o
+}
+
 func (b *Source) AddSubsource(subsources []TableStruct, textColumns []string) error {
 	var subsrc []string
 	for _, t := range subsources {
@@ -133,9 +157,8 @@ func (b *Source) AddSubsource(subsources []TableStruct, textColumns []string) er
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`ALTER SOURCE %s ADD SUBSOURCE %s`, b.QualifiedName(), s))
 
-	if len(textColumns) > 0 {
-		c := strings.Join(textColumns, ", ")
-		q.WriteString(fmt.Sprintf(` WITH (TEXT COLUMNS [%s])`, c))
+	if o := tableColumnOptions(subsources, textColumns, "[%s]"); len(o) > 0 {
+		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(o, ", ")))
 	}
 
 	q.WriteString(";")
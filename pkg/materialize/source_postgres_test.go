@@ -92,6 +92,38 @@ func TestSourcePostgresSpecificTablesCreate(t *testing.T) {
 
 }
 
+func TestSourcePostgresTableColumnsCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM POSTGRES CONNECTION "database"."schema"."pg_connection"
+			\(PUBLICATION 'mz_source', TEXT COLUMNS \(schema1.table_1.column_1, schema2.table_1.column_2\), EXCLUDE COLUMNS \(schema2.table_1.column_3\)\)
+			FOR TABLES \(schema1.table_1 AS s1_table_1, schema2.table_1 AS s2_table_1\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourcePostgresBuilder(db, sourcePostgres)
+		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Publication("mz_source")
+		b.Table([]TableStruct{
+			{
+				Name:        "schema1.table_1",
+				Alias:       "s1_table_1",
+				TextColumns: []string{"column_1"},
+			},
+			{
+				Name:           "schema2.table_1",
+				Alias:          "s2_table_1",
+				TextColumns:    []string{"column_2"},
+				ExcludeColumns: []string{"column_3"},
+			},
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestSourceAddSubsource(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -121,6 +153,26 @@ func TestSourceAddSubsourceTextColumns(t *testing.T) {
 	})
 }
 
+func TestSourceAddSubsourceTableColumns(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SOURCE "database"."schema"."source"
+			ADD SUBSOURCE "table_1", "table_2" AS "table_alias"
+			WITH \(TEXT COLUMNS \[table_1.column_1, table_2.column_2\], EXCLUDE COLUMNS \[table_2.column_3\]\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		tables := []TableStruct{
+			{Name: "table_1", TextColumns: []string{"column_1"}},
+			{Name: "table_2", Alias: "table_alias", TextColumns: []string{"column_2"}, ExcludeColumns: []string{"column_3"}},
+		}
+
+		b := NewSource(db, sourcePostgres)
+		if err := b.AddSubsource(tables, []string{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestSourceDropSubsource(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
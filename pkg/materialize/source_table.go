@@ -0,0 +1,377 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Tables created from a source with CREATE TABLE ... FROM SOURCE
+type SourceTable struct {
+	ddl          Builder
+	TableName    string
+	SchemaName   string
+	DatabaseName string
+}
+
+func NewSourceTable(conn *sqlx.DB, obj MaterializeObject) *SourceTable {
+	return &SourceTable{
+		ddl:          Builder{conn, Table},
+		TableName:    obj.Name,
+		SchemaName:   obj.SchemaName,
+		DatabaseName: obj.DatabaseName,
+	}
+}
+
+func (b *SourceTable) QualifiedName() string {
+	return QualifiedName(b.DatabaseName, b.SchemaName, b.TableName)
+}
+
+func (b *SourceTable) Rename(newName string) error {
+	n := QualifiedName(newName)
+	return b.ddl.rename(b.QualifiedName(), n)
+}
+
+func (b *SourceTable) Drop() error {
+	qn := b.QualifiedName()
+	return b.ddl.drop(qn)
+}
+
+func (b *SourceTable) create(source IdentifierSchemaStruct, reference string, options string) string {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE TABLE %s FROM SOURCE %s`, b.QualifiedName(), source.QualifiedName()))
+
+	if reference != "" {
+		q.WriteString(fmt.Sprintf(` (REFERENCE %s)`, reference))
+	}
+
+	q.WriteString(options)
+	return q.String()
+}
+
+func sourceTableReference(schemaName, name string) string {
+	if schemaName == "" {
+		return QuoteIdentifier(name)
+	}
+	return QualifiedName(schemaName, name)
+}
+
+func sourceTableColumnOptions(textColumns, excludeColumns []string) string {
+	var o []string
+
+	if len(textColumns) > 0 {
+		o = append(o, fmt.Sprintf(`TEXT COLUMNS (%s)`, strings.Join(quoteIdentifiers(textColumns), ", ")))
+	}
+
+	if len(excludeColumns) > 0 {
+		o = append(o, fmt.Sprintf(`EXCLUDE COLUMNS (%s)`, strings.Join(quoteIdentifiers(excludeColumns), ", ")))
+	}
+
+	if len(o) > 0 {
+		return fmt.Sprintf(` WITH (%s)`, strings.Join(o, ", "))
+	}
+	return ""
+}
+
+func quoteIdentifiers(v []string) []string {
+	var q []string
+	for _, i := range v {
+		q = append(q, QuoteIdentifier(i))
+	}
+	return q
+}
+
+type SourceTablePostgresBuilder struct {
+	SourceTable
+	source             IdentifierSchemaStruct
+	upstreamName       string
+	upstreamSchemaName string
+	textColumns        []string
+	excludeColumns     []string
+}
+
+func NewSourceTablePostgresBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTablePostgresBuilder {
+	return &SourceTablePostgresBuilder{
+		SourceTable: *NewSourceTable(conn, obj),
+	}
+}
+
+func (b *SourceTablePostgresBuilder) Source(s IdentifierSchemaStruct) *SourceTablePostgresBuilder {
+	b.source = s
+	return b
+}
+
+func (b *SourceTablePostgresBuilder) UpstreamName(n string) *SourceTablePostgresBuilder {
+	b.upstreamName = n
+	return b
+}
+
+func (b *SourceTablePostgresBuilder) UpstreamSchemaName(n string) *SourceTablePostgresBuilder {
+	b.upstreamSchemaName = n
+	return b
+}
+
+func (b *SourceTablePostgresBuilder) TextColumns(c []string) *SourceTablePostgresBuilder {
+	b.textColumns = c
+	return b
+}
+
+func (b *SourceTablePostgresBuilder) ExcludeColumns(c []string) *SourceTablePostgresBuilder {
+	b.excludeColumns = c
+	return b
+}
+
+func (b *SourceTablePostgresBuilder) Create() error {
+	r := sourceTableReference(b.upstreamSchemaName, b.upstreamName)
+	o := sourceTableColumnOptions(b.textColumns, b.excludeColumns)
+	q := b.create(b.source, r, o)
+	return b.ddl.exec(q + `;`)
+}
+
+type SourceTableMySQLBuilder struct {
+	SourceTable
+	source             IdentifierSchemaStruct
+	upstreamName       string
+	upstreamSchemaName string
+	textColumns        []string
+	excludeColumns     []string
+}
+
+func NewSourceTableMySQLBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableMySQLBuilder {
+	return &SourceTableMySQLBuilder{
+		SourceTable: *NewSourceTable(conn, obj),
+	}
+}
+
+func (b *SourceTableMySQLBuilder) Source(s IdentifierSchemaStruct) *SourceTableMySQLBuilder {
+	b.source = s
+	return b
+}
+
+func (b *SourceTableMySQLBuilder) UpstreamName(n string) *SourceTableMySQLBuilder {
+	b.upstreamName = n
+	return b
+}
+
+func (b *SourceTableMySQLBuilder) UpstreamSchemaName(n string) *SourceTableMySQLBuilder {
+	b.upstreamSchemaName = n
+	return b
+}
+
+func (b *SourceTableMySQLBuilder) TextColumns(c []string) *SourceTableMySQLBuilder {
+	b.textColumns = c
+	return b
+}
+
+func (b *SourceTableMySQLBuilder) ExcludeColumns(c []string) *SourceTableMySQLBuilder {
+	b.excludeColumns = c
+	return b
+}
+
+func (b *SourceTableMySQLBuilder) Create() error {
+	r := sourceTableReference(b.upstreamSchemaName, b.upstreamName)
+	o := sourceTableColumnOptions(b.textColumns, b.excludeColumns)
+	q := b.create(b.source, r, o)
+	return b.ddl.exec(q + `;`)
+}
+
+type SourceTableKafkaBuilder struct {
+	SourceTable
+	source       IdentifierSchemaStruct
+	upstreamName string
+	kafkaSourceSpec
+}
+
+func NewSourceTableKafkaBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableKafkaBuilder {
+	return &SourceTableKafkaBuilder{
+		SourceTable: *NewSourceTable(conn, obj),
+	}
+}
+
+func (b *SourceTableKafkaBuilder) Source(s IdentifierSchemaStruct) *SourceTableKafkaBuilder {
+	b.source = s
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) UpstreamName(n string) *SourceTableKafkaBuilder {
+	b.upstreamName = n
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeKey() *SourceTableKafkaBuilder {
+	b.includeKey = true
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeHeaders() *SourceTableKafkaBuilder {
+	b.includeHeaders = true
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludePartition() *SourceTableKafkaBuilder {
+	b.includePartition = true
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeOffset() *SourceTableKafkaBuilder {
+	b.includeOffset = true
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeTimestamp() *SourceTableKafkaBuilder {
+	b.includeTimestamp = true
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeKeyAlias(alias string) *SourceTableKafkaBuilder {
+	b.includeKey = true
+	b.keyAlias = alias
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeHeadersAlias(alias string) *SourceTableKafkaBuilder {
+	b.includeHeaders = true
+	b.headersAlias = alias
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludePartitionAlias(alias string) *SourceTableKafkaBuilder {
+	b.includePartition = true
+	b.partitionAlias = alias
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeOffsetAlias(alias string) *SourceTableKafkaBuilder {
+	b.includeOffset = true
+	b.offsetAlias = alias
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) IncludeTimestampAlias(alias string) *SourceTableKafkaBuilder {
+	b.includeTimestamp = true
+	b.timestampAlias = alias
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) Format(f SourceFormatSpecStruct) *SourceTableKafkaBuilder {
+	b.format = f
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) KeyFormat(k SourceFormatSpecStruct) *SourceTableKafkaBuilder {
+	b.keyFormat = k
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) ValueFormat(v SourceFormatSpecStruct) *SourceTableKafkaBuilder {
+	b.valueFormat = v
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) Envelope(e KafkaSourceEnvelopeStruct) *SourceTableKafkaBuilder {
+	b.envelope = e
+	return b
+}
+
+func (b *SourceTableKafkaBuilder) Create() error {
+	var r string
+	if b.upstreamName != "" {
+		r = QuoteIdentifier(b.upstreamName)
+	}
+
+	o, err := b.kafkaSourceSpec.clauses()
+	if err != nil {
+		return err
+	}
+
+	q := b.create(b.source, r, o)
+	return b.ddl.exec(q + `;`)
+}
+
+type SourceTableParams struct {
+	TableId            sql.NullString `db:"id"`
+	TableName          sql.NullString `db:"name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"database_name"`
+	SourceName         sql.NullString `db:"source_name"`
+	SourceSchemaName   sql.NullString `db:"source_schema_name"`
+	SourceDatabaseName sql.NullString `db:"source_database_name"`
+	SourceType         sql.NullString `db:"source_type"`
+	UpstreamName       sql.NullString `db:"upstream_name"`
+	UpstreamSchemaName sql.NullString `db:"upstream_schema_name"`
+	Comment            sql.NullString `db:"comment"`
+	OwnerName          sql.NullString `db:"owner_name"`
+	Privileges         pq.StringArray `db:"privileges"`
+}
+
+var sourceTableQuery = NewBaseQuery(`
+	SELECT
+		mz_tables.id,
+		mz_tables.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_sources.name AS source_name,
+		source_schemas.name AS source_schema_name,
+		source_databases.name AS source_database_name,
+		mz_sources.type AS source_type,
+		COALESCE(mz_postgres_source_tables.table_name, mz_mysql_source_tables.table_name, mz_kafka_source_tables.topic) AS upstream_name,
+		COALESCE(mz_postgres_source_tables.schema_name, mz_mysql_source_tables.schema_name) AS upstream_schema_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name,
+		mz_tables.privileges
+	FROM mz_tables
+	JOIN mz_schemas
+		ON mz_tables.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_sources
+		ON mz_tables.source_id = mz_sources.id
+	JOIN mz_schemas AS source_schemas
+		ON mz_sources.schema_id = source_schemas.id
+	JOIN mz_databases AS source_databases
+		ON source_schemas.database_id = source_databases.id
+	LEFT JOIN mz_internal.mz_postgres_source_tables
+		ON mz_tables.id = mz_postgres_source_tables.id
+	LEFT JOIN mz_internal.mz_mysql_source_tables
+		ON mz_tables.id = mz_mysql_source_tables.id
+	LEFT JOIN mz_internal.mz_kafka_source_tables
+		ON mz_tables.id = mz_kafka_source_tables.id
+	JOIN mz_roles
+		ON mz_tables.owner_id = mz_roles.id
+	LEFT JOIN (
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'table'
+		AND object_sub_id IS NULL
+	) comments
+		ON mz_tables.id = comments.id`)
+
+func SourceTableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	p := map[string]string{
+		"mz_tables.name":    obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q := sourceTableQuery.QueryPredicate(p)
+
+	var c SourceTableParams
+	if err := conn.Get(&c, q); err != nil {
+		return "", err
+	}
+
+	return c.TableId.String, nil
+}
+
+func ScanSourceTable(conn *sqlx.DB, id string) (SourceTableParams, error) {
+	q := sourceTableQuery.QueryPredicate(map[string]string{"mz_tables.id": id})
+
+	var c SourceTableParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
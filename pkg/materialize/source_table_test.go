@@ -0,0 +1,100 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceTable = MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+
+func TestSourceTablePostgresCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table"
+			FROM SOURCE "database"."schema"."source"
+			\(REFERENCE "public"."upstream_table"\)
+			WITH \(TEXT COLUMNS \("column_1", "column_2"\), EXCLUDE COLUMNS \("column_3"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTablePostgresBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"})
+		b.UpstreamName("upstream_table")
+		b.UpstreamSchemaName("public")
+		b.TextColumns([]string{"column_1", "column_2"})
+		b.ExcludeColumns([]string{"column_3"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableMySQLCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table"
+			FROM SOURCE "database"."schema"."source"
+			\(REFERENCE "upstream_table"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableMySQLBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"})
+		b.UpstreamName("upstream_table")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableKafkaCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table"
+			FROM SOURCE "database"."schema"."source"
+			\(REFERENCE "topic"\)
+			FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
+			INCLUDE KEY AS key, TIMESTAMP
+			ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableKafkaBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"})
+		b.UpstreamName("topic")
+		b.Format(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}}})
+		b.IncludeKeyAlias("key")
+		b.IncludeTimestamp()
+		b.Envelope(KafkaSourceEnvelopeStruct{Upsert: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableRename(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER TABLE "database"."schema"."table" RENAME TO "new_table";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSourceTable(db, sourceTable).Rename("new_table"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableDrop(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`DROP TABLE "database"."schema"."table";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSourceTable(db, sourceTable).Drop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
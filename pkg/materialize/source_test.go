@@ -3,6 +3,11 @@ package materialize
 import (
 	"reflect"
 	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAreEqual(t *testing.T) {
@@ -41,3 +46,15 @@ func TestDiffTableStructs(t *testing.T) {
 		t.Fatalf("Expect %s %s to be equal", o, e)
 	}
 }
+
+func TestSourceSnapshotCommitted(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockSourceSnapshotScan(mock, `WHERE mz_source_statistics.id = 'u1'`, true)
+
+		c, err := SourceSnapshotCommitted(db, "u1")
+		r.NoError(err)
+		r.True(c)
+	})
+}
@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -31,6 +32,49 @@ type CheckOptionsStruct struct {
 	Bytes bool
 }
 
+// Check options and expression validating requests from common webhook providers
+func WebhookValidationPreset(preset string, secret IdentifierSchemaStruct, header string) ([]CheckOptionsStruct, string, error) {
+	switch strings.ToUpper(preset) {
+	case "SEGMENT":
+		options := []CheckOptionsStruct{
+			{Field: FieldStruct{Body: true}, Bytes: true},
+			{Field: FieldStruct{Headers: true}},
+			{Field: FieldStruct{Secret: secret}, Alias: "secret", Bytes: true},
+		}
+		return options, "constant_time_eq(decode(headers->'x-signature', 'hex'), hmac(body, secret, 'sha1'))", nil
+	case "STRIPE":
+		options := []CheckOptionsStruct{
+			{Field: FieldStruct{Body: true}},
+			{Field: FieldStruct{Headers: true}},
+			{Field: FieldStruct{Secret: secret}, Alias: "secret"},
+		}
+		return options, "constant_time_eq(regexp_split_to_array(headers->'stripe-signature', ',|=')[4], encode(hmac(regexp_split_to_array(headers->'stripe-signature', ',|=')[2] || '.' || body, secret, 'sha256'), 'hex'))", nil
+	case "GITHUB":
+		options := []CheckOptionsStruct{
+			{Field: FieldStruct{Body: true}, Bytes: true},
+			{Field: FieldStruct{Headers: true}},
+			{Field: FieldStruct{Secret: secret}, Alias: "secret", Bytes: true},
+		}
+		return options, "constant_time_eq(decode(split_part(headers->'x-hub-signature-256', '=', 2), 'hex'), hmac(body, secret, 'sha256'))", nil
+	case "HMAC_SHA256":
+		if header == "" {
+			return nil, "", fmt.Errorf("header is required for the HMAC_SHA256 validation preset")
+		}
+		options := []CheckOptionsStruct{
+			{Field: FieldStruct{Body: true}, Bytes: true},
+			{Field: FieldStruct{Headers: true}},
+			{Field: FieldStruct{Secret: secret}, Alias: "secret", Bytes: true},
+		}
+		return options, fmt.Sprintf("constant_time_eq(decode(headers->%s, 'hex'), hmac(body, secret, 'sha256'))", QuoteString(strings.ToLower(header))), nil
+	}
+	return nil, "", fmt.Errorf("unknown webhook validation preset %s", preset)
+}
+
+// The URL accepting requests for a webhook source
+func WebhookUrl(host string, obj MaterializeObject) string {
+	return fmt.Sprintf("https://%s/api/webhook/%s/%s/%s", host, url.PathEscape(obj.DatabaseName), url.PathEscape(obj.SchemaName), url.PathEscape(obj.Name))
+}
+
 type SourceWebhookBuilder struct {
 	Source
 	clusterName     string
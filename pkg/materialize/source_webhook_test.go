@@ -190,3 +190,124 @@ func TestSourceWebhookCreateRudderstack(t *testing.T) {
 		}
 	})
 }
+
+var webhookSecret = IdentifierSchemaStruct{DatabaseName: "database", SchemaName: "schema", Name: "webhook_secret"}
+
+func TestSourceWebhookCreateValidationPresetSegment(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK
+			\( WITH \(BODY BYTES, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret BYTES\)
+			constant_time_eq\(decode\(headers->'x-signature', 'hex'\), hmac\(body, secret, 'sha1'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		options, expression, err := WebhookValidationPreset("segment", webhookSecret, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b.ClusterName("cluster")
+		b.BodyFormat("JSON")
+		b.CheckOptions(options)
+		b.CheckExpression(expression)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceWebhookCreateValidationPresetStripe(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT TEXT CHECK
+			\( WITH \(BODY, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret\)
+			constant_time_eq\(regexp_split_to_array\(headers->'stripe-signature', ',\|='\)\[4\], encode\(hmac\(regexp_split_to_array\(headers->'stripe-signature', ',\|='\)\[2\] \|\| '.' \|\| body, secret, 'sha256'\), 'hex'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		options, expression, err := WebhookValidationPreset("STRIPE", webhookSecret, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b.ClusterName("cluster")
+		b.BodyFormat("TEXT")
+		b.CheckOptions(options)
+		b.CheckExpression(expression)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceWebhookCreateValidationPresetGithub(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK
+			\( WITH \(BODY BYTES, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret BYTES\)
+			constant_time_eq\(decode\(split_part\(headers->'x-hub-signature-256', '=', 2\), 'hex'\), hmac\(body, secret, 'sha256'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		options, expression, err := WebhookValidationPreset("GITHUB", webhookSecret, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b.ClusterName("cluster")
+		b.BodyFormat("JSON")
+		b.CheckOptions(options)
+		b.CheckExpression(expression)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceWebhookCreateValidationPresetHmac(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK
+			\( WITH \(BODY BYTES, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret BYTES\)
+			constant_time_eq\(decode\(headers->'x-custom-signature', 'hex'\), hmac\(body, secret, 'sha256'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		options, expression, err := WebhookValidationPreset("HMAC_SHA256", webhookSecret, "X-Custom-Signature")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b.ClusterName("cluster")
+		b.BodyFormat("JSON")
+		b.CheckOptions(options)
+		b.CheckExpression(expression)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestWebhookValidationPresetInvalid(t *testing.T) {
+	if _, _, err := WebhookValidationPreset("HMAC_SHA256", webhookSecret, ""); err == nil {
+		t.Fatal("expected error for HMAC_SHA256 preset without header")
+	}
+
+	if _, _, err := WebhookValidationPreset("UNKNOWN", webhookSecret, ""); err == nil {
+		t.Fatal("expected error for unknown preset")
+	}
+}
+
+func TestWebhookUrl(t *testing.T) {
+	o := MaterializeObject{Name: "webhook source", SchemaName: "schema", DatabaseName: "database"}
+	u := WebhookUrl("abc.us-east-1.aws.materialize.cloud", o)
+	e := "https://abc.us-east-1.aws.materialize.cloud/api/webhook/database/schema/webhook%20source"
+	if u != e {
+		t.Fatalf("unexpected url %s", u)
+	}
+}
@@ -0,0 +1,224 @@
+package materialize
+
+import (
+	"strings"
+	"unicode"
+)
+
+type statementToken struct {
+	text  string
+	ident bool
+}
+
+// NormalizeStatement reduces a select statement to a canonical form so the
+// definition Materialize stores can be compared with the statement it was
+// created from. Whitespace, comments and the case of keywords and unquoted
+// identifiers are ignored, as are quotes around identifiers, the database,
+// public, pg_catalog and mz_catalog schema qualifiers Materialize adds to the
+// names of objects, functions and types, and aliases of type names in casts.
+func NormalizeStatement(statement, databaseName string) string {
+	tokens := tokenizeStatement(statement)
+
+	for len(tokens) > 0 && tokens[len(tokens)-1].text == ";" && !tokens[len(tokens)-1].ident {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	tokens = unqualifyTokens(tokens, databaseName, 3)
+	tokens = unqualifyTokens(tokens, "public", 2)
+	tokens = unqualifyTokens(tokens, "pg_catalog", 2)
+	tokens = unqualifyTokens(tokens, "mz_catalog", 2)
+	tokens = canonicalizeTypes(tokens)
+
+	var t []string
+	for _, token := range tokens {
+		t = append(t, token.text)
+	}
+	return strings.Join(t, " ")
+}
+
+func tokenizeStatement(statement string) []statementToken {
+	var tokens []statementToken
+	r := []rune(statement)
+
+	for i := 0; i < len(r); {
+		c := r[i]
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+
+		case c == '-' && i+1 < len(r) && r[i+1] == '-':
+			for i < len(r) && r[i] != '\n' {
+				i++
+			}
+
+		case c == '/' && i+1 < len(r) && r[i+1] == '*':
+			i += 2
+			for i < len(r) && !(r[i] == '*' && i+1 < len(r) && r[i+1] == '/') {
+				i++
+			}
+			i += 2
+
+		case c == '\'' || c == '"':
+			// Quotes are escaped by doubling them
+			j := i + 1
+			var b strings.Builder
+			for j < len(r) {
+				if r[j] == c {
+					if j+1 < len(r) && r[j+1] == c {
+						b.WriteRune(c)
+						j += 2
+						continue
+					}
+					break
+				}
+				b.WriteRune(r[j])
+				j++
+			}
+
+			// An unterminated literal runs to the end of the statement
+			end := j + 1
+			if j == len(r) {
+				end = j
+			}
+
+			if c == '"' {
+				tokens = append(tokens, statementToken{text: b.String(), ident: true})
+			} else {
+				tokens = append(tokens, statementToken{text: string(r[i:end])})
+			}
+			i = end
+
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(r) && (unicode.IsLetter(r[j]) || unicode.IsDigit(r[j]) || r[j] == '_' || r[j] == '$') {
+				j++
+			}
+			tokens = append(tokens, statementToken{text: strings.ToLower(string(r[i:j])), ident: true})
+			i = j
+
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(r) && (unicode.IsDigit(r[j]) || r[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, statementToken{text: string(r[i:j])})
+			i = j
+
+		case strings.ContainsRune("+-*/<>=~!@#%^&|:", c):
+			j := i
+			for j < len(r) && strings.ContainsRune("+-*/<>=~!@#%^&|:", r[j]) {
+				j++
+			}
+			tokens = append(tokens, statementToken{text: string(r[i:j])})
+			i = j
+
+		default:
+			tokens = append(tokens, statementToken{text: string(c)})
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// Drop the leading qualifier from names of the given length, such as the
+// database of a fully qualified object name
+func unqualifyTokens(tokens []statementToken, qualifier string, parts int) []statementToken {
+	isDot := func(i int) bool {
+		return i >= 0 && i < len(tokens) && !tokens[i].ident && tokens[i].text == "."
+	}
+
+	var t []statementToken
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].ident && tokens[i].text == qualifier && !isDot(i-1) {
+			n := 1
+			for isDot(i+2*n-1) && i+2*n < len(tokens) && tokens[i+2*n].ident {
+				n++
+			}
+			if n == parts {
+				i++
+				continue
+			}
+		}
+		t = append(t, tokens[i])
+	}
+
+	return t
+}
+
+// Type names with their aliases, longest first so multi-word names are
+// matched before their first word
+var typeAliases = []struct {
+	alias []string
+	name  string
+}{
+	{[]string{"timestamp", "with", "time", "zone"}, "timestamptz"},
+	{[]string{"timestamp", "without", "time", "zone"}, "timestamp"},
+	{[]string{"time", "without", "time", "zone"}, "time"},
+	{[]string{"character", "varying"}, "varchar"},
+	{[]string{"double", "precision"}, "float8"},
+	{[]string{"smallint"}, "int2"},
+	{[]string{"int"}, "int4"},
+	{[]string{"integer"}, "int4"},
+	{[]string{"bigint"}, "int8"},
+	{[]string{"real"}, "float4"},
+	{[]string{"float"}, "float8"},
+	{[]string{"boolean"}, "bool"},
+	{[]string{"decimal"}, "numeric"},
+	{[]string{"string"}, "text"},
+	{[]string{"json"}, "jsonb"},
+}
+
+// Replace aliases of type names with the name Materialize stores, for the
+// types of casts written as ::type or CAST(... AS type)
+func canonicalizeTypes(tokens []statementToken) []statementToken {
+	isOperator := func(i int, text string) bool {
+		return i >= 0 && i < len(tokens) && !tokens[i].ident && tokens[i].text == text
+	}
+
+	var t []statementToken
+	var casts []bool
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		t = append(t, token)
+
+		switch {
+		case isOperator(i, "("):
+			casts = append(casts, i > 0 && tokens[i-1].ident && tokens[i-1].text == "cast")
+			continue
+		case isOperator(i, ")"):
+			if len(casts) > 0 {
+				casts = casts[:len(casts)-1]
+			}
+			continue
+		case isOperator(i, "::"):
+		case token.ident && token.text == "as" && len(casts) > 0 && casts[len(casts)-1]:
+		default:
+			continue
+		}
+
+		for _, a := range typeAliases {
+			if typeAliasAt(tokens, i+1, a.alias) {
+				t = append(t, statementToken{text: a.name, ident: true})
+				i += len(a.alias)
+				break
+			}
+		}
+	}
+
+	return t
+}
+
+func typeAliasAt(tokens []statementToken, i int, alias []string) bool {
+	if i+len(alias) > len(tokens) {
+		return false
+	}
+
+	for n, word := range alias {
+		if !tokens[i+n].ident || tokens[i+n].text != word {
+			return false
+		}
+	}
+	return true
+}
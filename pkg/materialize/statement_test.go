@@ -0,0 +1,51 @@
+package materialize
+
+import (
+	"testing"
+)
+
+func TestNormalizeStatementEquivalent(t *testing.T) {
+	cases := [][]string{
+		{`SELECT 1 AS id`, `SELECT 1 AS "id";`},
+		{"SELECT\n    *\nFROM\n    t\n", `SELECT * FROM "materialize"."public"."t"`},
+		{`select count(*) from public.t`, `SELECT count(*) FROM "materialize"."public"."t";`},
+		{`SELECT a+b FROM s.t -- sum`, `SELECT "a" + "b" FROM "materialize"."s"."t"`},
+		{`SELECT 'It''s' AS v`, `SELECT 'It''s' AS "v"`},
+		{`SELECT id::int4 FROM t /* cast */`, `SELECT "id"::int4 FROM "materialize"."public"."t"`},
+		{`SELECT count(*) FROM t`, `SELECT "pg_catalog"."count"(*) FROM "materialize"."public"."t"`},
+		{`SELECT now() AS ts`, `SELECT "mz_catalog"."now"() AS "ts"`},
+		{`SELECT id::int FROM t`, `SELECT "id"::"pg_catalog"."int4" FROM "materialize"."public"."t"`},
+		{`SELECT CAST(id AS integer) AS id FROM t`, `SELECT CAST("id" AS "pg_catalog"."int4") AS "id" FROM "materialize"."public"."t"`},
+		{`SELECT v::double precision, w::bigint`, `SELECT "v"::"pg_catalog"."float8", "w"::"pg_catalog"."int8"`},
+		{`SELECT cast(ts AS timestamp with time zone)`, `SELECT CAST("ts" AS "pg_catalog"."timestamptz")`},
+		{`SELECT sum(v::real) FROM t`, `SELECT "pg_catalog"."sum"("v"::"pg_catalog"."float4") FROM "materialize"."public"."t"`},
+	}
+
+	for _, c := range cases {
+		if n, d := NormalizeStatement(c[0], "materialize"), NormalizeStatement(c[1], "materialize"); n != d {
+			t.Errorf("expected %q and %q to be equivalent, normalized to %q and %q", c[0], c[1], n, d)
+		}
+	}
+}
+
+func TestNormalizeStatementDifferent(t *testing.T) {
+	cases := [][]string{
+		{`SELECT 1 AS id`, `SELECT 2 AS "id"`},
+		{`SELECT 'a' AS v`, `SELECT 'A' AS "v"`},
+		{`SELECT "Id" FROM t`, `SELECT "id" FROM "materialize"."public"."t"`},
+		{`SELECT * FROM t`, `SELECT * FROM "materialize"."other"."t"`},
+		{`SELECT * FROM t`, `SELECT * FROM "other"."public"."t"`},
+		{`SELECT 'a`, `SELECT 'a'`},
+		{`SELECT id FROM t WHERE name = 'unterminated valu`, `SELECT id FROM t WHERE name = 'unterminated valu'`},
+		{`SELECT "a`, `SELECT "a" || '`},
+		{`SELECT id::int4 FROM t`, `SELECT "id"::"pg_catalog"."int8" FROM "materialize"."public"."t"`},
+		{`SELECT count(*) FROM t`, `SELECT "other"."count"(*) FROM "materialize"."public"."t"`},
+		{`SELECT 1 AS int`, `SELECT 1 AS "int4"`},
+	}
+
+	for _, c := range cases {
+		if n, d := NormalizeStatement(c[0], "materialize"), NormalizeStatement(c[1], "materialize"); n == d {
+			t.Errorf("expected %q and %q to differ, both normalized to %q", c[0], c[1], n)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type SystemParameterBuilder struct {
+	ddl           Builder
+	parameterName string
+}
+
+func NewSystemParameterBuilder(conn *sqlx.DB, parameterName string) *SystemParameterBuilder {
+	return &SystemParameterBuilder{
+		ddl:           Builder{conn, SystemParameter},
+		parameterName: parameterName,
+	}
+}
+
+func (b *SystemParameterBuilder) Set(value string) error {
+	q := fmt.Sprintf(`ALTER SYSTEM SET %s = %s;`, b.parameterName, QuoteString(value))
+	return b.ddl.exec(q)
+}
+
+func (b *SystemParameterBuilder) Reset() error {
+	q := fmt.Sprintf(`ALTER SYSTEM RESET %s;`, b.parameterName)
+	return b.ddl.exec(q)
+}
+
+type SystemParameterParams struct {
+	Name        sql.NullString `db:"name"`
+	Setting     sql.NullString `db:"setting"`
+	Description sql.NullString `db:"description"`
+}
+
+func ScanSystemParameter(conn *sqlx.DB, parameterName string) (string, error) {
+	q := fmt.Sprintf(`SHOW %s;`, parameterName)
+
+	var c string
+	if err := conn.QueryRow(q).Scan(&c); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+func ListSystemParameters(conn *sqlx.DB) ([]SystemParameterParams, error) {
+	q := `SHOW ALL;`
+
+	var c []SystemParameterParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,35 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+// https://materialize.com/docs/sql/alter-system-set/
+
+func TestSystemParameterSet(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SYSTEM SET max_tables = '100';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSystemParameterBuilder(db, "max_tables").Set("100"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSystemParameterReset(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SYSTEM RESET max_tables;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSystemParameterBuilder(db, "max_tables").Reset(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
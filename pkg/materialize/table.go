@@ -84,6 +84,12 @@ func (b *TableBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+// Materialize only supports adding nullable columns without a default
+func (b *TableBuilder) AddColumn(c TableColumn) error {
+	q := fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s %s;`, b.QualifiedName(), c.ColName, c.ColType)
+	return b.ddl.exec(q)
+}
+
 func (b *TableBuilder) Rename(newName string) error {
 	n := QualifiedName(newName)
 	return b.ddl.rename(b.QualifiedName(), n)
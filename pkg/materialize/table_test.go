@@ -53,6 +53,20 @@ func TestTableCreate(t *testing.T) {
 	})
 }
 
+func TestTableAddColumn(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER TABLE "database"."schema"."table" ADD COLUMN f text;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+		c := TableColumn{ColName: "f", ColType: "text"}
+		if err := NewTableBuilder(db, o).AddColumn(c); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestTableRename(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -16,6 +16,8 @@ type ViewBuilder struct {
 	schemaName   string
 	databaseName string
 	selectStmt   string
+	temporary    string
+	backup       string
 }
 
 func NewViewBuilder(conn *sqlx.DB, obj MaterializeObject) *ViewBuilder {
@@ -41,6 +43,59 @@ func (b *ViewBuilder) Create() error {
 	return b.ddl.exec(q)
 }
 
+// The new definition is created under a temporary name so an invalid
+// statement leaves the view untouched
+func (b *ViewBuilder) TemporaryName() string {
+	if b.temporary == "" {
+		b.temporary = replacementName(b.viewName, "tmp")
+	}
+	return b.temporary
+}
+
+func (b *ViewBuilder) TemporaryObject() MaterializeObject {
+	return MaterializeObject{ObjectType: "VIEW", Name: b.TemporaryName(), SchemaName: b.schemaName, DatabaseName: b.databaseName}
+}
+
+func (b *ViewBuilder) temporaryQualifiedName() string {
+	return QualifiedName(b.databaseName, b.schemaName, b.TemporaryName())
+}
+
+func (b *ViewBuilder) CreateTemporary() error {
+	q := fmt.Sprintf(`CREATE VIEW %s AS %s;`, b.temporaryQualifiedName(), b.selectStmt)
+	return b.ddl.exec(q)
+}
+
+func (b *ViewBuilder) DropTemporary() error {
+	return b.ddl.drop(b.temporaryQualifiedName())
+}
+
+// The view is kept under a backup name while the temporary view takes its place
+func (b *ViewBuilder) BackupName() string {
+	if b.backup == "" {
+		b.backup = replacementName(b.viewName, "old")
+	}
+	return b.backup
+}
+
+func (b *ViewBuilder) backupQualifiedName() string {
+	return QualifiedName(b.databaseName, b.schemaName, b.BackupName())
+}
+
+// Replace moves the temporary view into the place of the view, which is kept
+// under the backup name until DropBackup. Both renames run in one transaction
+// so the view is never left without a definition, and the temporary view is
+// left to be dropped if they fail.
+func (b *ViewBuilder) Replace() error {
+	return b.ddl.execTransaction(
+		b.ddl.renameStatement(b.QualifiedName(), QualifiedName(b.BackupName())),
+		b.ddl.renameStatement(b.temporaryQualifiedName(), QualifiedName(b.viewName)),
+	)
+}
+
+func (b *ViewBuilder) DropBackup() error {
+	return b.ddl.drop(b.backupQualifiedName())
+}
+
 func (b *ViewBuilder) Rename(newName string) error {
 	n := QualifiedName(newName)
 	return b.ddl.rename(b.QualifiedName(), n)
@@ -57,6 +112,7 @@ type ViewParams struct {
 	ViewName     sql.NullString `db:"name"`
 	SchemaName   sql.NullString `db:"schema_name"`
 	DatabaseName sql.NullString `db:"database_name"`
+	Definition   sql.NullString `db:"definition"`
 	Comment      sql.NullString `db:"comment"`
 	OwnerName    sql.NullString `db:"owner_name"`
 	Privileges   pq.StringArray `db:"privileges"`
@@ -68,6 +124,7 @@ var viewQuery = NewBaseQuery(`
 		mz_views.name,
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
+		mz_views.definition,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_views.privileges
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"fmt"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -27,6 +28,88 @@ func TestViewCreate(t *testing.T) {
 	})
 }
 
+func TestViewCreateTemporary(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE VIEW "database"."schema"."view_tmp_[0-9a-f]{8}" AS SELECT 1 FROM t1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o)
+		b.SelectStmt("SELECT 1 FROM t1")
+
+		if err := b.CreateTemporary(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestViewReplacementNames(t *testing.T) {
+	o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+	b := NewViewBuilder(nil, o)
+
+	if b.TemporaryName() != b.TemporaryName() || b.BackupName() != b.BackupName() {
+		t.Fatal("expected the replacement names to be stable for a builder")
+	}
+
+	if b.TemporaryName() == NewViewBuilder(nil, o).TemporaryName() {
+		t.Fatalf("expected a unique temporary name, got %s twice", b.TemporaryName())
+	}
+}
+
+func TestViewReplace(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			fmt.Sprintf(`ALTER VIEW "database"."schema"."view" RENAME TO "%s";`, b.BackupName()),
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			fmt.Sprintf(`ALTER VIEW "database"."schema"."%s" RENAME TO "view";`, b.TemporaryName()),
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		if err := b.Replace(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestViewReplaceRollback(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		b := NewViewBuilder(db, o)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(
+			fmt.Sprintf(`ALTER VIEW "database"."schema"."view" RENAME TO "%s";`, b.BackupName()),
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(
+			fmt.Sprintf(`ALTER VIEW "database"."schema"."%s" RENAME TO "view";`, b.TemporaryName()),
+		).WillReturnError(fmt.Errorf("rename failed"))
+		mock.ExpectRollback()
+
+		if err := b.Replace(); err == nil || err.Error() != "rename failed" {
+			t.Fatalf("expected the rename error, got %v", err)
+		}
+	})
+}
+
+func TestViewDropBackup(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`DROP VIEW "database"."schema"."view_old_[0-9a-f]{8}";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewViewBuilder(db, o).DropBackup(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestViewRename(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -52,3 +135,16 @@ func TestViewDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestViewDropTemporary(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`DROP VIEW "database"."schema"."view_tmp_[0-9a-f]{8}";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewViewBuilder(db, o).DropTemporary(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccClusterSwap_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSwapResource(nameSpace, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_cluster_swap.test", "id", fmt.Sprintf("aws/us-east-1:CLUSTER SWAP|%[1]s_blue|%[1]s_green", nameSpace)),
+					resource.TestCheckResourceAttr("materialize_cluster_swap.test", "cluster_name", nameSpace+"_blue"),
+					resource.TestCheckResourceAttr("materialize_cluster_swap.test", "swap_with", nameSpace+"_green"),
+					testAccCheckClusterNamed("materialize_cluster.blue", nameSpace+"_blue"),
+				),
+			},
+			{
+				Config: testAccClusterSwapResource(nameSpace, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_cluster_swap.test", "trigger", "v2"),
+					testAccCheckClusterNamed("materialize_cluster.green", nameSpace+"_blue"),
+					testAccCheckClusterNamed("materialize_cluster.blue", nameSpace+"_green"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterSwapResource(nameSpace, trigger string) string {
+	return fmt.Sprintf(`
+	resource "materialize_cluster" "blue" {
+		name               = "%[1]s_blue"
+		size               = "3xsmall"
+		replication_factor = 0
+
+		lifecycle {
+			ignore_changes = [name]
+		}
+	}
+
+	resource "materialize_cluster" "green" {
+		name               = "%[1]s_green"
+		size               = "3xsmall"
+		replication_factor = 0
+
+		lifecycle {
+			ignore_changes = [name]
+		}
+	}
+
+	resource "materialize_cluster_swap" "test" {
+		cluster_name     = materialize_cluster.blue.name
+		swap_with        = materialize_cluster.green.name
+		trigger          = "%[2]s"
+		wait_until_ready = true
+
+		lifecycle {
+			ignore_changes = [cluster_name, swap_with]
+		}
+	}
+	`, nameSpace, trigger)
+}
+
+// The cluster created by the resource has the name, after any swaps
+func testAccCheckClusterNamed(name, clusterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("cluster not found: %s", name)
+		}
+		i, err := materialize.ClusterId(db, materialize.MaterializeObject{Name: clusterName})
+		if err != nil {
+			return err
+		}
+		if i != utils.ExtractId(r.Primary.ID) {
+			return fmt.Errorf("cluster %s is not named %s", name, clusterName)
+		}
+		return nil
+	}
+}
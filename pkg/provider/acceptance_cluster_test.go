@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -217,6 +218,37 @@ func TestAccCluster_updateSize(t *testing.T) {
 	})
 }
 
+func TestAccCluster_updateSizeGraceful(t *testing.T) {
+	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterManagedGracefulResource(clusterName, "3xsmall"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("materialize_cluster.test"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "size", "3xsmall"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "resize_strategy", "graceful"),
+				),
+			},
+			{
+				Config: testAccClusterManagedGracefulResource(clusterName, "2xsmall"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_cluster.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("materialize_cluster.test"),
+					resource.TestCheckResourceAttr("materialize_cluster.test", "size", "2xsmall"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCluster_updateReplicationFactor(t *testing.T) {
 	clusterName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	resource.ParallelTest(t, resource.TestCase{
@@ -328,6 +360,21 @@ func testAccClusterManagedNoReplicationResource(clusterName, clusterSize string)
 		clusterName, clusterSize)
 }
 
+func testAccClusterManagedGracefulResource(clusterName, clusterSize string) string {
+	return fmt.Sprintf(`
+	resource "materialize_cluster" "test" {
+		name            = "%[1]s"
+		size            = "%[2]s"
+		resize_strategy = "graceful"
+
+		timeouts {
+			update = "15m"
+		}
+	}
+	`,
+		clusterName, clusterSize)
+}
+
 func testAccClusterManagedZeroReplicationResource(clusterName, clusterSize string) string {
 	return fmt.Sprintf(`
 	resource "materialize_cluster" "test" {
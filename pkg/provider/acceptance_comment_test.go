@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccComment_basic(t *testing.T) {
+	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommentResource(tableName, "table comment", "column comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommentExists("materialize_comment.test"),
+					testAccCheckCommentExists("materialize_comment.test_column"),
+					resource.TestMatchResourceAttr("materialize_comment.test", "id", terraformCommentIdRegex),
+					resource.TestCheckResourceAttr("materialize_comment.test", "object_type", "TABLE"),
+					resource.TestCheckResourceAttr("materialize_comment.test", "object_name", tableName),
+					resource.TestCheckResourceAttr("materialize_comment.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_comment.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_comment.test", "comment", "table comment"),
+					resource.TestCheckResourceAttr("materialize_comment.test_column", "column_name", "column_1"),
+					resource.TestCheckResourceAttr("materialize_comment.test_column", "comment", "column comment"),
+				),
+			},
+			{
+				ResourceName:      "materialize_comment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "materialize_comment.test_column",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComment_update(t *testing.T) {
+	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllCommentsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommentResource(tableName, "table comment", "column comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommentExists("materialize_comment.test"),
+				),
+			},
+			{
+				Config: testAccCommentResource(tableName, "new table comment", "new column comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommentExists("materialize_comment.test"),
+					resource.TestCheckResourceAttr("materialize_comment.test", "comment", "new table comment"),
+					resource.TestCheckResourceAttr("materialize_comment.test_column", "comment", "new column comment"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCommentResource(tableName, tableComment, columnComment string) string {
+	return fmt.Sprintf(`
+resource "materialize_table" "test" {
+	name = "%[1]s"
+
+	column {
+		name = "column_1"
+		type = "text"
+	}
+}
+
+resource "materialize_comment" "test" {
+	object_type   = "TABLE"
+	object_name   = materialize_table.test.name
+	schema_name   = materialize_table.test.schema_name
+	database_name = materialize_table.test.database_name
+	comment       = "%[2]s"
+}
+
+resource "materialize_comment" "test_column" {
+	object_type   = "TABLE"
+	object_name   = materialize_table.test.name
+	schema_name   = materialize_table.test.schema_name
+	database_name = materialize_table.test.database_name
+	column_name   = "column_1"
+	comment       = "%[3]s"
+}
+`, tableName, tableComment, columnComment)
+}
+
+func testAccCommentKey(id string) ([]string, error) {
+	key := strings.Split(id, "|")
+	if len(key) < 3 {
+		return nil, fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+	if len(key) == 3 {
+		key = append(key, "")
+	}
+	return key, nil
+}
+
+func testAccCheckCommentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("comment not found: %s", name)
+		}
+		key, err := testAccCommentKey(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = materialize.ScanComment(db, key[1], key[2], key[3])
+		return err
+	}
+}
+
+func testAccCheckAllCommentsDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_comment" {
+			continue
+		}
+
+		key, err := testAccCommentKey(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = materialize.ScanComment(db, key[1], key[2], key[3])
+		if err == nil {
+			return fmt.Errorf("comment %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -86,6 +87,39 @@ func TestAccConnPostgres_update(t *testing.T) {
 	})
 }
 
+func TestAccConnPostgres_alter(t *testing.T) {
+	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnPostgresDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnPostgresAlterResource(secretName, connectionName, "postgres", 5432),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnPostgresExists("materialize_connection_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_connection_postgres.test", "host", "postgres"),
+					resource.TestCheckResourceAttr("materialize_connection_postgres.test", "port", "5432"),
+				),
+			},
+			{
+				Config: testAccConnPostgresAlterResource(secretName, connectionName, "postgres-replica", 5433),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_connection_postgres.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnPostgresExists("materialize_connection_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_connection_postgres.test", "host", "postgres-replica"),
+					resource.TestCheckResourceAttr("materialize_connection_postgres.test", "port", "5433"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccConnPostgres_disappears(t *testing.T) {
 	secretName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -160,6 +194,31 @@ resource "materialize_connection_postgres" "test_role" {
 `, roleName, secretName, connectionName, connection2Name, connectionOwner)
 }
 
+func testAccConnPostgresAlterResource(secretName, connectionName, host string, port int) string {
+	return fmt.Sprintf(`
+resource "materialize_secret" "postgres_password" {
+	name  = "%[1]s"
+	value = "c2VjcmV0Cg=="
+}
+
+resource "materialize_connection_postgres" "test" {
+	name = "%[2]s"
+	host = "%[3]s"
+	port = %[4]d
+	user {
+		text = "postgres"
+	}
+	password {
+		name          = materialize_secret.postgres_password.name
+		schema_name   = materialize_secret.postgres_password.schema_name
+		database_name = materialize_secret.postgres_password.database_name
+	}
+	database = "postgres"
+	validate = false
+}
+`, secretName, connectionName, host, port)
+}
+
 func testAccCheckConnPostgresExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -80,6 +80,49 @@ func TestAccConnSshTunnel_update(t *testing.T) {
 	})
 }
 
+func TestAccConnSshTunnel_rotateKeys(t *testing.T) {
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	var publicKey2 string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnSshTunnelDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnSshTunnelRotationResource(connectionName, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnSshTunnelExists("materialize_connection_ssh_tunnel.test"),
+					resource.TestCheckResourceAttr("materialize_connection_ssh_tunnel.test", "rotation_trigger", "initial"),
+					resource.TestCheckResourceAttrWith("materialize_connection_ssh_tunnel.test", "public_key_2", func(value string) error {
+						publicKey2 = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccConnSshTunnelRotationResource(connectionName, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnSshTunnelExists("materialize_connection_ssh_tunnel.test"),
+					resource.TestCheckResourceAttr("materialize_connection_ssh_tunnel.test", "rotation_trigger", "rotated"),
+					// The previous secondary key is promoted to primary
+					resource.TestCheckResourceAttrWith("materialize_connection_ssh_tunnel.test", "public_key_1", func(value string) error {
+						if value != publicKey2 {
+							return fmt.Errorf("expected public_key_1 to be the previous public_key_2 %q, got %q", publicKey2, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("materialize_connection_ssh_tunnel.test", "public_key_2", func(value string) error {
+						if value == publicKey2 {
+							return fmt.Errorf("expected public_key_2 to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccConnSshTunnel_disappears(t *testing.T) {
 	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	connection2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -134,6 +177,19 @@ resource "materialize_connection_ssh_tunnel" "test_role" {
 `, roleName, connectionName, connection2Name, connectionOwner)
 }
 
+func testAccConnSshTunnelRotationResource(connectionName, rotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "materialize_connection_ssh_tunnel" "test" {
+	name             = "%[1]s"
+	schema_name      = "public"
+	host             = "ssh_host"
+	user             = "ssh_user"
+	port             = 22
+	rotation_trigger = "%[2]s"
+}
+`, connectionName, rotationTrigger)
+}
+
 func testAccCheckConnSshTunnelExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceClusterReplicaSizes_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "materialize_cluster_replica_sizes" "all" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.materialize_cluster_replica_sizes.all", "sizes.#", regexp.MustCompile("([1-9]\\d*)")),
+					resource.TestCheckResourceAttrSet("data.materialize_cluster_replica_sizes.all", "sizes.0.size"),
+					resource.TestCheckResourceAttrSet("data.materialize_cluster_replica_sizes.all", "sizes.0.workers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterInvalidSize(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "materialize_cluster" "invalid" {
+					name = "invalid_size_cluster"
+					size = "not-a-size"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected size to be one of \(.+\), got 'not-a-size'`),
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -49,6 +50,29 @@ func TestAccMaterializedView_basic(t *testing.T) {
 	})
 }
 
+func TestAccMaterializedView_waitUntilReady(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	indexName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaterializedViewWaitUntilReadyResource(viewName, indexName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "wait_until_ready", "true"),
+					testAccCheckObjectHydrated("materialize_materialized_view.test"),
+					testAccCheckIndexExists("materialize_index.test"),
+					resource.TestCheckResourceAttr("materialize_index.test", "wait_until_ready", "true"),
+					testAccCheckObjectHydrated("materialize_index.test"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMaterializedView_update(t *testing.T) {
 	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
 	viewName := fmt.Sprintf("old_%s", slug)
@@ -86,6 +110,67 @@ func TestAccMaterializedView_update(t *testing.T) {
 	})
 }
 
+func TestAccMaterializedView_createThenSwap(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaterializedViewCreateThenSwapResource(nameSpace, "SELECT 1 AS id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+				),
+			},
+			{
+				// The sink is moved to the new definition rather than replaced
+				Config: testAccMaterializedViewCreateThenSwapResource(nameSpace, "SELECT 2 AS id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_materialized_view.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("materialize_sink_kafka.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "name", fmt.Sprintf("%s_mv", nameSpace)),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "statement", "SELECT 2 AS id"),
+					testAccCheckObjectHydrated("materialize_materialized_view.test"),
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "from.0.name", fmt.Sprintf("%s_mv", nameSpace)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMaterializedView_statementNormalized(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	// Functions and cast types are stored qualified, and type aliases under their type name
+	statement := "SELECT count(*) AS n, sum(a::bigint) AS s, CAST(max(a) AS integer) AS m, avg(a)::double precision AS f FROM (VALUES (1), (2)) AS t (a)"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaterializedViewStatementResource(viewName, statement),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaterializedViewExists("materialize_materialized_view.test"),
+					resource.TestCheckResourceAttr("materialize_materialized_view.test", "statement", statement),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccMaterializedView_disappears(t *testing.T) {
 	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	view2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -138,6 +223,100 @@ func testAccMaterializedViewResource(roleName, materializeViewName, materializeV
 	`, roleName, materializeViewName, materializeView2Name, materializeViewOwner, comment)
 }
 
+func testAccMaterializedViewWaitUntilReadyResource(materializeViewName, indexName string) string {
+	return fmt.Sprintf(`
+	resource "materialize_materialized_view" "test" {
+		name             = "%[1]s"
+		statement        = "SELECT 1 AS id"
+		cluster_name     = "default"
+		wait_until_ready = true
+	}
+
+	resource "materialize_index" "test" {
+		name             = "%[2]s"
+		cluster_name     = "default"
+		wait_until_ready = true
+
+		obj_name {
+			name          = materialize_materialized_view.test.name
+			schema_name   = materialize_materialized_view.test.schema_name
+			database_name = materialize_materialized_view.test.database_name
+		}
+
+		col_expr {
+			field = "id"
+		}
+	}
+	`, materializeViewName, indexName)
+}
+
+func testAccMaterializedViewStatementResource(materializedViewName, statement string) string {
+	return fmt.Sprintf(`
+	resource "materialize_materialized_view" "test" {
+		name = "%[1]s"
+		statement = "%[2]s"
+		cluster_name = "default"
+	}
+	`, materializedViewName, statement)
+}
+
+func testAccMaterializedViewCreateThenSwapResource(nameSpace, statement string) string {
+	return fmt.Sprintf(`
+	resource "materialize_connection_kafka" "test" {
+		name = "%[1]s_conn"
+		kafka_broker {
+			broker = "redpanda:9092"
+		}
+		security_protocol = "PLAINTEXT"
+	}
+
+	resource "materialize_materialized_view" "test" {
+		name                 = "%[1]s_mv"
+		statement            = "%[2]s"
+		cluster_name         = "default"
+		replacement_strategy = "create_then_swap"
+	}
+
+	resource "materialize_sink_kafka" "test" {
+		name = "%[1]s_sink"
+		kafka_connection {
+			name = materialize_connection_kafka.test.name
+		}
+		from {
+			name = materialize_materialized_view.test.name
+		}
+		size  = "3xsmall"
+		topic = "%[1]s_topic"
+		format {
+			json = true
+		}
+		envelope {
+			debezium = true
+		}
+	}
+	`, nameSpace, statement)
+}
+
+func testAccCheckObjectHydrated(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Object not found: %s", name)
+		}
+		h, err := materialize.ListObjectHydrationStatuses(db, utils.ExtractId(r.Primary.ID))
+		if err != nil {
+			return err
+		}
+		for _, s := range h {
+			if !s.Hydrated.Bool {
+				return fmt.Errorf("Object %s is not hydrated on replica %s", name, s.ReplicaName.String)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckMaterializedViewExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccRoleParameter_basic(t *testing.T) {
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleParameterResource(roleName, "cluster", "quickstart"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleParameterExists(roleName, "cluster"),
+					resource.TestMatchResourceAttr("materialize_role_parameter.test", "id", terraformRoleParameterIdRegex),
+					resource.TestCheckResourceAttr("materialize_role_parameter.test", "role_name", roleName),
+					resource.TestCheckResourceAttr("materialize_role_parameter.test", "variable_name", "cluster"),
+					resource.TestCheckResourceAttr("materialize_role_parameter.test", "variable_value", "quickstart"),
+				),
+			},
+			{
+				ResourceName:      "materialize_role_parameter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoleParameter_update(t *testing.T) {
+	roleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllRoleParametersReset,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleParameterResource(roleName, "cluster", "quickstart"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleParameterExists(roleName, "cluster"),
+				),
+			},
+			{
+				Config: testAccRoleParameterResource(roleName, "cluster", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleParameterExists(roleName, "cluster"),
+					resource.TestCheckResourceAttr("materialize_role_parameter.test", "variable_value", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleParameterResource(roleName, variableName, variableValue string) string {
+	return fmt.Sprintf(`
+resource "materialize_role" "test" {
+	name = "%[1]s"
+}
+
+resource "materialize_role_parameter" "test" {
+	role_name      = materialize_role.test.name
+	variable_name  = "%[2]s"
+	variable_value = "%[3]s"
+}
+`, roleName, variableName, variableValue)
+}
+
+func testAccCheckRoleParameterExists(roleName, variableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		roleId, err := materialize.RoleId(db, roleName)
+		if err != nil {
+			return err
+		}
+		_, err = materialize.ScanRoleParameter(db, roleId, variableName)
+		return err
+	}
+}
+
+func testAccCheckAllRoleParametersReset(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_role_parameter" {
+			continue
+		}
+
+		key := strings.Split(r.Primary.ID, "|")
+		if len(key) != 3 {
+			return fmt.Errorf("%s cannot be parsed correctly", r.Primary.ID)
+		}
+
+		_, err := materialize.ScanRoleParameter(db, key[1], key[2])
+		if err == nil {
+			return fmt.Errorf("role parameter %v still exists", r.Primary.ID)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccSchemaSwap_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaSwapResource(nameSpace, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_schema_swap.test", "id", fmt.Sprintf("aws/us-east-1:SCHEMA SWAP|materialize|%[1]s_blue|%[1]s_green", nameSpace)),
+					resource.TestCheckResourceAttr("materialize_schema_swap.test", "schema_name", nameSpace+"_blue"),
+					resource.TestCheckResourceAttr("materialize_schema_swap.test", "swap_with", nameSpace+"_green"),
+					resource.TestCheckResourceAttr("materialize_schema_swap.test", "database_name", "materialize"),
+					testAccCheckSchemaNamed("materialize_schema.blue", nameSpace+"_blue"),
+				),
+			},
+			{
+				Config: testAccSchemaSwapResource(nameSpace, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_schema_swap.test", "trigger", "v2"),
+					testAccCheckSchemaNamed("materialize_schema.green", nameSpace+"_blue"),
+					testAccCheckSchemaNamed("materialize_schema.blue", nameSpace+"_green"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchemaSwapResource(nameSpace, trigger string) string {
+	return fmt.Sprintf(`
+	resource "materialize_schema" "blue" {
+		name = "%[1]s_blue"
+
+		lifecycle {
+			ignore_changes = [name]
+		}
+	}
+
+	resource "materialize_schema" "green" {
+		name = "%[1]s_green"
+
+		lifecycle {
+			ignore_changes = [name]
+		}
+	}
+
+	resource "materialize_schema_swap" "test" {
+		schema_name      = materialize_schema.blue.name
+		swap_with        = materialize_schema.green.name
+		trigger          = "%[2]s"
+		wait_until_ready = true
+
+		lifecycle {
+			ignore_changes = [schema_name, swap_with]
+		}
+	}
+	`, nameSpace, trigger)
+}
+
+// The schema created by the resource has the name, after any swaps
+func testAccCheckSchemaNamed(name, schemaName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("schema not found: %s", name)
+		}
+		i, err := materialize.SchemaId(db, materialize.MaterializeObject{Name: schemaName, DatabaseName: "materialize"})
+		if err != nil {
+			return err
+		}
+		if i != utils.ExtractId(r.Primary.ID) {
+			return fmt.Errorf("schema %s is not named %s", name, schemaName)
+		}
+		return nil
+	}
+}
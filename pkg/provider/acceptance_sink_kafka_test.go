@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -101,6 +102,33 @@ func TestAccSinkKafkaAvro_basic(t *testing.T) {
 	})
 }
 
+func TestAccSinkKafkaTopicOptions_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSinkKafkaDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkKafkaTopicOptionsResource(nameSpace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "topic", nameSpace+"_topic"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "compression_type", "gzip"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "topic_partition_count", "3"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "topic_replication_factor", "1"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "topic_config.cleanup.policy", "compact"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "key_format.0.text", "true"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "value_format.0.json", "true"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "wait_for_running", "true"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "status", "running"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "error", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSinkKafka_update(t *testing.T) {
 	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
 	sinkName := fmt.Sprintf("old_%s", slug)
@@ -143,6 +171,36 @@ func TestAccSinkKafka_update(t *testing.T) {
 	})
 }
 
+func TestAccSinkKafka_updateFrom(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSinkKafkaDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkKafkaFromResource(nameSpace, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "from.0.name", nameSpace+"_blue"),
+				),
+			},
+			{
+				Config: testAccSinkKafkaFromResource(nameSpace, "green"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_sink_kafka.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkKafkaExists("materialize_sink_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_sink_kafka.test", "from.0.name", nameSpace+"_green"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSinkKafka_disappears(t *testing.T) {
 	sinkName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	sink2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -332,6 +390,118 @@ func testAccSinkKafkaAvroResource(sinkName string) string {
 	`, sinkName)
 }
 
+func testAccSinkKafkaTopicOptionsResource(nameSpace string) string {
+	return fmt.Sprintf(`
+	resource "materialize_connection_kafka" "test" {
+		name = "%[1]s_conn"
+		kafka_broker {
+			broker = "redpanda:9092"
+		}
+		security_protocol = "PLAINTEXT"
+	}
+
+	resource "materialize_table" "test" {
+		name = "%[1]s_table"
+		column {
+			name = "id"
+			type = "text"
+		}
+		column {
+			name = "value"
+			type = "int"
+		}
+	}
+
+	resource "materialize_sink_kafka" "test" {
+		name = "%[1]s_sink"
+		kafka_connection {
+			name = materialize_connection_kafka.test.name
+		}
+		from {
+			name = materialize_table.test.name
+		}
+		size                     = "3xsmall"
+		topic                    = "%[1]s_topic"
+		compression_type         = "gzip"
+		partition_by             = "seahash(id)"
+		topic_partition_count    = 3
+		topic_replication_factor = 1
+		topic_config = {
+			"cleanup.policy" = "compact"
+		}
+		progress_group_id_prefix = "%[1]s_progress"
+		transactional_id_prefix  = "%[1]s_transaction"
+		key                      = ["id"]
+		key_not_enforced         = true
+		key_format {
+			text = true
+		}
+		value_format {
+			json = true
+		}
+		envelope {
+			upsert = true
+		}
+		wait_for_running = true
+	}
+	`, nameSpace)
+}
+
+func testAccSinkKafkaFromResource(nameSpace, from string) string {
+	return fmt.Sprintf(`
+	resource "materialize_connection_kafka" "test" {
+		name = "%[1]s_conn"
+		kafka_broker {
+			broker = "redpanda:9092"
+		}
+		security_protocol = "PLAINTEXT"
+	}
+
+	resource "materialize_table" "blue" {
+		name = "%[1]s_blue"
+		column {
+			name = "id"
+			type = "text"
+		}
+		column {
+			name = "value"
+			type = "int"
+		}
+	}
+
+	resource "materialize_table" "green" {
+		name = "%[1]s_green"
+		column {
+			name = "id"
+			type = "text"
+		}
+		column {
+			name = "value"
+			type = "int"
+		}
+	}
+
+	resource "materialize_sink_kafka" "test" {
+		name = "%[1]s_sink"
+		kafka_connection {
+			name = materialize_connection_kafka.test.name
+		}
+		from {
+			name = materialize_table.%[2]s.name
+		}
+		size  = "3xsmall"
+		topic = "%[1]s_topic"
+		key   = ["id"]
+		format {
+			json = true
+		}
+		envelope {
+			upsert = true
+		}
+	}
+	`, nameSpace, from)
+}
+
 func testAccCheckSinkKafkaExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -77,6 +77,29 @@ func TestAccSourceKafka_basic(t *testing.T) {
 	})
 }
 
+func TestAccSourceKafkaUpsertOptions_basic(t *testing.T) {
+	addTestTopic()
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceKafkaDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceKafkaUpsertOptionsResource(nameSpace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceKafkaExists("materialize_source_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_source_kafka.test", "group_id_prefix", nameSpace+"_group"),
+					resource.TestCheckResourceAttr("materialize_source_kafka.test", "topic_metadata_refresh_interval", "30s"),
+					resource.TestCheckResourceAttr("materialize_source_kafka.test", "envelope.0.upsert", "true"),
+					resource.TestCheckResourceAttr("materialize_source_kafka.test", "envelope.0.upsert_options.0.value_decoding_errors.0.inline.0.enabled", "true"),
+					resource.TestCheckResourceAttr("materialize_source_kafka.test", "envelope.0.upsert_options.0.value_decoding_errors.0.inline.0.alias", "decoding_error"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSourceKafkaAvro_basic(t *testing.T) {
 	addTestTopic()
 	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -246,6 +269,47 @@ func testAccSourceKafkaResource(roleName, connName, sourceName, source2Name, sou
 `, roleName, connName, sourceName, source2Name, sourceOwner, comment)
 }
 
+func testAccSourceKafkaUpsertOptionsResource(nameSpace string) string {
+	return fmt.Sprintf(`
+	resource "materialize_connection_kafka" "test" {
+		name = "%[1]s_conn"
+		kafka_broker {
+			broker = "redpanda:9092"
+		}
+		security_protocol = "PLAINTEXT"
+	}
+
+	resource "materialize_source_kafka" "test" {
+		name = "%[1]s_source"
+		kafka_connection {
+			name = materialize_connection_kafka.test.name
+		}
+
+		size                            = "3xsmall"
+		topic                           = "terraform"
+		group_id_prefix                 = "%[1]s_group"
+		topic_metadata_refresh_interval = "30s"
+		key_format {
+			text = true
+		}
+		value_format {
+			text = true
+		}
+		envelope {
+			upsert = true
+			upsert_options {
+				value_decoding_errors {
+					inline {
+						enabled = true
+						alias   = "decoding_error"
+					}
+				}
+			}
+		}
+	}
+`, nameSpace)
+}
+
 func testAccSourceKafkaResourceAvro(sourceName string) string {
 	return fmt.Sprintf(`
 	resource "materialize_connection_kafka" "test" {
@@ -142,6 +142,66 @@ func TestAccSourceLoadGeneratorTPCH_basic(t *testing.T) {
 	})
 }
 
+func TestAccSourceLoadGeneratorKeyValue_basic(t *testing.T) {
+	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceLoadGeneratorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceLoadGeneratorKeyValueResource(sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceLoadGeneratorExists("materialize_source_load_generator.test"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "load_generator_type", "KEY VALUE"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "key_value_options.0.keys", "16"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "key_value_options.0.transactional_snapshot", "true"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "up_to", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceLoadGeneratorClock_basic(t *testing.T) {
+	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceLoadGeneratorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceLoadGeneratorClockResource(sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceLoadGeneratorExists("materialize_source_load_generator.test"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "load_generator_type", "CLOCK"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "clock_options.0.tick_interval", "1s"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceLoadGenerator_waitForSnapshot(t *testing.T) {
+	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceLoadGeneratorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceLoadGeneratorWaitForSnapshotResource(sourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceLoadGeneratorExists("materialize_source_load_generator.test"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "wait_for_snapshot", "true"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "status", "running"),
+					resource.TestCheckResourceAttr("materialize_source_load_generator.test", "error", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSourceLoadGenerator_update(t *testing.T) {
 	slug := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
 	sourceName := fmt.Sprintf("old_%s", slug)
@@ -300,6 +360,53 @@ func testAccSourceLoadGeneratorTPCHResource(sourceName string) string {
 	`, sourceName)
 }
 
+func testAccSourceLoadGeneratorKeyValueResource(sourceName string) string {
+	return fmt.Sprintf(`
+	resource "materialize_source_load_generator" "test" {
+		name = "%[1]s"
+		size = "3xsmall"
+		load_generator_type = "KEY VALUE"
+		key_value_options {
+			keys            = 16
+			snapshot_rounds = 1
+			value_size      = 8
+			seed            = 42
+			partitions      = 2
+			batch_size      = 2
+		}
+		up_to = 100
+	}
+	`, sourceName)
+}
+
+func testAccSourceLoadGeneratorClockResource(sourceName string) string {
+	return fmt.Sprintf(`
+	resource "materialize_source_load_generator" "test" {
+		name = "%[1]s"
+		size = "3xsmall"
+		load_generator_type = "CLOCK"
+		clock_options {
+			tick_interval = "1s"
+		}
+	}
+	`, sourceName)
+}
+
+func testAccSourceLoadGeneratorWaitForSnapshotResource(sourceName string) string {
+	return fmt.Sprintf(`
+	resource "materialize_source_load_generator" "test" {
+		name                = "%[1]s"
+		size                = "3xsmall"
+		load_generator_type = "AUCTION"
+		wait_for_snapshot   = true
+
+		auction_options {
+			tick_interval = "1s"
+		}
+	}
+	`, sourceName)
+}
+
 func testAccCheckSourceLoadGeneratorExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -151,6 +152,40 @@ func TestAccSourcePostgres_update(t *testing.T) {
 	})
 }
 
+func TestAccSourcePostgres_tableColumns(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourcePostgresDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourcePostgresTableColumnsResource(nameSpace, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourcePostgresExists("materialize_source_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_source_postgres.test", "table.#", "1"),
+					resource.TestCheckResourceAttr("materialize_source_postgres.test", "table.0.text_columns.#", "1"),
+					resource.TestCheckResourceAttr("materialize_source_postgres.test", "table.0.text_columns.0", "id"),
+				),
+			},
+			{
+				Config: testAccSourcePostgresTableColumnsResource(nameSpace, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_source_postgres.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourcePostgresExists("materialize_source_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_source_postgres.test", "table.#", "2"),
+					resource.TestCheckResourceAttr("materialize_source_postgres.test", "table.1.name", "table3"),
+					resource.TestCheckResourceAttr("materialize_source_postgres.test", "table.1.text_columns.0", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSourcePostgres_disappears(t *testing.T) {
 	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	source2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -248,6 +283,56 @@ func testAccSourcePostgresResource(roleName, secretName, connName, sourceName, s
 	`, roleName, secretName, connName, sourceName, source2Name, sourceOwner, comment)
 }
 
+func testAccSourcePostgresTableColumnsResource(nameSpace string, addTable bool) string {
+	table := ""
+	if addTable {
+		table = fmt.Sprintf(`
+		table {
+			name         = "table3"
+			alias        = "%[1]s_table3"
+			text_columns = ["id"]
+		}`, nameSpace)
+	}
+
+	return fmt.Sprintf(`
+	resource "materialize_secret" "postgres_password" {
+		name  = "%[1]s_secret"
+		value = "c2VjcmV0Cg=="
+	}
+
+	resource "materialize_connection_postgres" "test" {
+		name = "%[1]s_conn"
+		host = "postgres"
+		port = 5432
+		user {
+			text = "postgres"
+		}
+		password {
+			name          = materialize_secret.postgres_password.name
+			schema_name   = materialize_secret.postgres_password.schema_name
+			database_name = materialize_secret.postgres_password.database_name
+		}
+		database = "postgres"
+	}
+
+	resource "materialize_source_postgres" "test" {
+		name = "%[1]s_source"
+		postgres_connection {
+			name = materialize_connection_postgres.test.name
+		}
+
+		size        = "3xsmall"
+		publication = "mz_source"
+		table {
+			name         = "table1"
+			alias        = "%[1]s_table1"
+			text_columns = ["id"]
+		}
+		%[2]s
+	}
+	`, nameSpace, table)
+}
+
 func testAccSourcePostgresResourceUpdate(roleName, secretName, connName, sourceName, source2Name, sourceOwner, comment string) string {
 	return fmt.Sprintf(`
 	resource "materialize_role" "test" {
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAccSourceTablePostgres_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceTablesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceTablePostgresResource(nameSpace, nameSpace+"_table", "Comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table_postgres.test"),
+					resource.TestMatchResourceAttr("materialize_source_table_postgres.test", "id", terraformObjectIdRegex),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "name", nameSpace+"_table"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, nameSpace+"_table")),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "source.0.name", nameSpace+"_source"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "upstream_name", "table2"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "upstream_schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "text_columns.#", "1"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "text_columns.0", "updated_at"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "comment", "Comment"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "ownership_role", "mz_system"),
+				),
+			},
+			{
+				Config: testAccSourceTablePostgresResource(nameSpace, nameSpace+"_table_renamed", "Updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table_postgres.test"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "name", nameSpace+"_table_renamed"),
+					resource.TestCheckResourceAttr("materialize_source_table_postgres.test", "comment", "Updated comment"),
+				),
+			},
+			{
+				ResourceName:            "materialize_source_table_postgres.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"text_columns"},
+			},
+		},
+	})
+}
+
+func TestAccSourceTableKafka_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceTablesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceTableKafkaResource(nameSpace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table_kafka.test"),
+					resource.TestCheckResourceAttr("materialize_source_table_kafka.test", "name", nameSpace+"_table"),
+					resource.TestCheckResourceAttr("materialize_source_table_kafka.test", "source.0.name", nameSpace+"_source"),
+					resource.TestCheckResourceAttr("materialize_source_table_kafka.test", "upstream_name", "terraform"),
+					resource.TestCheckResourceAttr("materialize_source_table_kafka.test", "include_key", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceTablePostgres_disappears(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceTablesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceTablePostgresResource(nameSpace, nameSpace+"_table", "Comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceTableExists("materialize_source_table_postgres.test"),
+					testAccCheckObjectDisappears(
+						materialize.MaterializeObject{
+							ObjectType: "TABLE",
+							Name:       nameSpace + "_table",
+						},
+					),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceTablePostgresResource(nameSpace, tableName, comment string) string {
+	return fmt.Sprintf(`
+	resource "materialize_secret" "test" {
+		name  = "%[1]s_secret"
+		value = "c2VjcmV0Cg=="
+	}
+
+	resource "materialize_connection_postgres" "test" {
+		name = "%[1]s_conn"
+		host = "postgres"
+		port = 5432
+		user {
+			text = "postgres"
+		}
+		password {
+			name          = materialize_secret.test.name
+			schema_name   = materialize_secret.test.schema_name
+			database_name = materialize_secret.test.database_name
+		}
+		database = "postgres"
+	}
+
+	resource "materialize_source_postgres" "test" {
+		name = "%[1]s_source"
+		size = "3xsmall"
+		postgres_connection {
+			name = materialize_connection_postgres.test.name
+		}
+		publication  = "mz_source"
+		text_columns = ["table2.updated_at"]
+		table {
+			name  = "table1"
+			alias = "%[1]s_table1"
+		}
+	}
+
+	resource "materialize_source_table_postgres" "test" {
+		name = "%[2]s"
+		source {
+			name = materialize_source_postgres.test.name
+		}
+		upstream_name        = "table2"
+		upstream_schema_name = "public"
+		text_columns         = ["updated_at"]
+		comment              = "%[3]s"
+	}
+	`, nameSpace, tableName, comment)
+}
+
+func testAccSourceTableKafkaResource(nameSpace string) string {
+	return fmt.Sprintf(`
+	resource "materialize_connection_kafka" "test" {
+		name = "%[1]s_conn"
+		kafka_broker {
+			broker = "redpanda:9092"
+		}
+		security_protocol = "PLAINTEXT"
+	}
+
+	resource "materialize_source_kafka" "test" {
+		name = "%[1]s_source"
+		size  = "3xsmall"
+		topic = "terraform"
+		kafka_connection {
+			name = materialize_connection_kafka.test.name
+		}
+		key_format {
+			text = true
+		}
+		value_format {
+			text = true
+		}
+		envelope {
+			none = true
+		}
+	}
+
+	resource "materialize_source_table_kafka" "test" {
+		name = "%[1]s_table"
+		source {
+			name = materialize_source_kafka.test.name
+		}
+		upstream_name = "terraform"
+		key_format {
+			text = true
+		}
+		value_format {
+			text = true
+		}
+		include_key = true
+		envelope {
+			none = true
+		}
+	}
+	`, nameSpace)
+}
+
+func testAccCheckSourceTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := testAccProvider.Meta().(*sqlx.DB)
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("source table not found: %s", name)
+		}
+		_, err := materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		return err
+	}
+}
+
+func testAccCheckAllSourceTablesDestroyed(s *terraform.State) error {
+	db := testAccProvider.Meta().(*sqlx.DB)
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_source_table_postgres" && r.Type != "materialize_source_table_mysql" && r.Type != "materialize_source_table_kafka" {
+			continue
+		}
+
+		_, err := materialize.ScanSourceTable(db, utils.ExtractId(r.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("source table %v still exists", utils.ExtractId(r.Primary.ID))
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"database/sql"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -88,6 +89,26 @@ func TestAccSourceWebhookSegment_basic(t *testing.T) {
 	})
 }
 
+func TestAccSourceWebhookValidationPreset_basic(t *testing.T) {
+	nameSpace := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllSourceWebhookDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceWebhookValidationPresetResource(nameSpace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceWebhookExists("materialize_source_webhook.test"),
+					resource.TestCheckResourceAttr("materialize_source_webhook.test", "validation_preset.0.type", "GITHUB"),
+					resource.TestCheckResourceAttr("materialize_source_webhook.test", "validation_preset.0.secret.0.name", nameSpace+"_secret"),
+					resource.TestMatchResourceAttr("materialize_source_webhook.test", "url", regexp.MustCompile(fmt.Sprintf(`^https://.+/api/webhook/materialize/public/%s_source$`, nameSpace))),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSourceWebhookRudderstack_basic(t *testing.T) {
 	sourceName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	resource.ParallelTest(t, resource.TestCase{
@@ -290,6 +311,33 @@ func testAccSourceWebhookSegmentResource(sourceName string) string {
 	`, sourceName)
 }
 
+func testAccSourceWebhookValidationPresetResource(nameSpace string) string {
+	return fmt.Sprintf(`
+	resource "materialize_secret" "github" {
+		name  = "%[1]s_secret"
+		value = "c2VjcmV0Cg=="
+	}
+
+	resource "materialize_cluster" "example_cluster" {
+		name = "%[1]s_cluster"
+		size = "3xsmall"
+	}
+
+	resource "materialize_source_webhook" "test" {
+		name         = "%[1]s_source"
+		cluster_name = materialize_cluster.example_cluster.name
+		body_format  = "json"
+
+		validation_preset {
+			type = "GITHUB"
+			secret {
+				name = materialize_secret.github.name
+			}
+		}
+	}
+	`, nameSpace)
+}
+
 func testAccSourceWebhookRudderstackResource(sourceName string) string {
 	return fmt.Sprintf(`
 	resource "materialize_secret" "basic_auth" {
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSystemParameter_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemParameterResource("max_secrets", "150"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("materialize_system_parameter.test", "id", terraformObjectIdRegex),
+					resource.TestCheckResourceAttr("materialize_system_parameter.test", "name", "max_secrets"),
+					resource.TestCheckResourceAttr("materialize_system_parameter.test", "value", "150"),
+				),
+			},
+			{
+				Config: testAccSystemParameterResource("max_secrets", "200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("materialize_system_parameter.test", "value", "200"),
+				),
+			},
+			{
+				ResourceName:      "materialize_system_parameter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSystemParameterResource(name, value string) string {
+	return fmt.Sprintf(`
+resource "materialize_system_parameter" "test" {
+	name  = "%[1]s"
+	value = "%[2]s"
+}
+`, name, value)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -137,6 +138,40 @@ func TestAccTable_update(t *testing.T) {
 	})
 }
 
+func TestAccTable_addColumn(t *testing.T) {
+	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllTablesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableResourceAddColumn(tableName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists("materialize_table.test"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.#", "1"),
+				),
+			},
+			{
+				Config: testAccTableResourceAddColumn(tableName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists("materialize_table.test"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.1.name", "column_2"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.1.type", "integer"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.1.nullable", "false"),
+					resource.TestCheckResourceAttr("materialize_table.test", "column.1.comment", "added column"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTable_disappears(t *testing.T) {
 	tableName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	tableRoleName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -297,3 +332,25 @@ func testAccTableResourceWithUpdates(roleName, tableName, tableRoleName, tableOw
 	}
 	`, roleName, tableName, columnName1, commentColumn2, tableOwnership, tableRoleName, tableOwnership)
 }
+
+func testAccTableResourceAddColumn(tableName string, addColumn bool) string {
+	column2 := ""
+	if addColumn {
+		column2 = `
+		column {
+			name    = "column_2"
+			type    = "int"
+			comment = "added column"
+		}`
+	}
+
+	return fmt.Sprintf(`
+	resource "materialize_table" "test" {
+		name = "%[1]s"
+		column {
+			name = "column_1"
+			type = "text"
+		}%[2]s
+	}
+	`, tableName, column2)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jmoiron/sqlx"
 )
@@ -88,6 +89,87 @@ func TestAccView_update(t *testing.T) {
 	})
 }
 
+func TestAccView_updateStatement(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewStatementResource(viewName, "SELECT 1 AS id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "statement", "SELECT 1 AS id"),
+				),
+			},
+			{
+				// Appending a column updates the view in place
+				Config: testAccViewStatementResource(viewName, "SELECT 1 AS id, 2 AS total"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_view.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "name", viewName),
+					resource.TestCheckResourceAttr("materialize_view.test", "statement", "SELECT 1 AS id, 2 AS total"),
+					resource.TestCheckResourceAttr("materialize_view.test", "comment", "Comment"),
+				),
+			},
+			{
+				// Changing the type of a column replaces the view
+				Config: testAccViewStatementResource(viewName, "SELECT 'a' AS id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("materialize_view.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists("materialize_view.test"),
+					resource.TestCheckResourceAttr("materialize_view.test", "statement", "SELECT 'a' AS id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccView_statementNormalized(t *testing.T) {
+	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	statements := []string{
+		// Functions are stored qualified with pg_catalog or mz_catalog
+		"select count(*) as n, max(a) as m from (values (1), (2)) as t (a)",
+		// Cast types are stored qualified
+		"SELECT a::bigint AS b, CAST(a AS text) AS c FROM (VALUES (1)) AS t (a)",
+		// Type aliases are stored under their type name
+		"SELECT 1::int AS i, CAST(2 AS integer) AS j, 1.5::double precision AS f, now()::timestamp with time zone AS ts",
+	}
+
+	var steps []resource.TestStep
+	for _, statement := range statements {
+		steps = append(steps, resource.TestStep{
+			Config: testAccViewStatementResource(viewName, statement),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckViewExists("materialize_view.test"),
+				resource.TestCheckResourceAttr("materialize_view.test", "statement", statement),
+			),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PostApplyPostRefresh: []plancheck.PlanCheck{
+					plancheck.ExpectEmptyPlan(),
+				},
+			},
+		})
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps:             steps,
+	})
+}
+
 func TestAccView_disappears(t *testing.T) {
 	viewName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	view2Name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
@@ -137,6 +219,16 @@ func testAccViewResource(roleName, viewName, view2Name, viewOwner, comment strin
 	`, roleName, viewName, view2Name, viewOwner, comment)
 }
 
+func testAccViewStatementResource(viewName, statement string) string {
+	return fmt.Sprintf(`
+	resource "materialize_view" "test" {
+		name = "%[1]s"
+		statement = "%[2]s"
+		comment = "Comment"
+	}
+	`, viewName, statement)
+}
+
 func testAccCheckViewExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		db := testAccProvider.Meta().(*sqlx.DB)
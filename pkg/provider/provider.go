@@ -61,6 +61,8 @@ func Provider(version string) *schema.Provider {
 			"materialize_cluster_grant":                        resources.GrantCluster(),
 			"materialize_cluster_grant_default_privilege":      resources.GrantClusterDefaultPrivilege(),
 			"materialize_cluster_replica":                      resources.ClusterReplica(),
+			"materialize_cluster_swap":                         resources.ClusterSwap(),
+			"materialize_comment":                              resources.Comment(),
 			"materialize_connection_aws_privatelink":           resources.ConnectionAwsPrivatelink(),
 			"materialize_connection_confluent_schema_registry": resources.ConnectionConfluentSchemaRegistry(),
 			"materialize_connection_kafka":                     resources.ConnectionKafka(),
@@ -77,9 +79,11 @@ func Provider(version string) *schema.Provider {
 			"materialize_materialized_view_grant":              resources.GrantMaterializedView(),
 			"materialize_role":                                 resources.Role(),
 			"materialize_role_grant":                           resources.GrantRole(),
+			"materialize_role_parameter":                       resources.RoleParameter(),
 			"materialize_schema":                               resources.Schema(),
 			"materialize_schema_grant":                         resources.GrantSchema(),
 			"materialize_schema_grant_default_privilege":       resources.GrantSchemaDefaultPrivilege(),
+			"materialize_schema_swap":                          resources.SchemaSwap(),
 			"materialize_secret":                               resources.Secret(),
 			"materialize_secret_grant":                         resources.GrantSecret(),
 			"materialize_secret_grant_default_privilege":       resources.GrantSecretDefaultPrivilege(),
@@ -89,6 +93,10 @@ func Provider(version string) *schema.Provider {
 			"materialize_source_postgres":                      resources.SourcePostgres(),
 			"materialize_source_webhook":                       resources.SourceWebhook(),
 			"materialize_source_grant":                         resources.GrantSource(),
+			"materialize_source_table_kafka":                   resources.SourceTableKafka(),
+			"materialize_source_table_mysql":                   resources.SourceTableMySQL(),
+			"materialize_source_table_postgres":                resources.SourceTablePostgres(),
+			"materialize_system_parameter":                     resources.SystemParameter(),
 			"materialize_table":                                resources.Table(),
 			"materialize_table_grant":                          resources.GrantTable(),
 			"materialize_table_grant_default_privilege":        resources.GrantTableDefaultPrivilege(),
@@ -99,23 +107,25 @@ func Provider(version string) *schema.Provider {
 			"materialize_view_grant":                           resources.GrantView(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"materialize_cluster":           datasources.Cluster(),
-			"materialize_cluster_replica":   datasources.ClusterReplica(),
-			"materialize_connection":        datasources.Connection(),
-			"materialize_current_database":  datasources.CurrentDatabase(),
-			"materialize_current_cluster":   datasources.CurrentCluster(),
-			"materialize_database":          datasources.Database(),
-			"materialize_egress_ips":        datasources.EgressIps(),
-			"materialize_index":             datasources.Index(),
-			"materialize_materialized_view": datasources.MaterializedView(),
-			"materialize_role":              datasources.Role(),
-			"materialize_schema":            datasources.Schema(),
-			"materialize_secret":            datasources.Secret(),
-			"materialize_sink":              datasources.Sink(),
-			"materialize_source":            datasources.Source(),
-			"materialize_table":             datasources.Table(),
-			"materialize_type":              datasources.Type(),
-			"materialize_view":              datasources.View(),
+			"materialize_cluster":               datasources.Cluster(),
+			"materialize_cluster_replica":       datasources.ClusterReplica(),
+			"materialize_cluster_replica_sizes": datasources.ClusterReplicaSizes(),
+			"materialize_connection":            datasources.Connection(),
+			"materialize_current_database":      datasources.CurrentDatabase(),
+			"materialize_current_cluster":       datasources.CurrentCluster(),
+			"materialize_database":              datasources.Database(),
+			"materialize_egress_ips":            datasources.EgressIps(),
+			"materialize_index":                 datasources.Index(),
+			"materialize_materialized_view":     datasources.MaterializedView(),
+			"materialize_role":                  datasources.Role(),
+			"materialize_schema":                datasources.Schema(),
+			"materialize_secret":                datasources.Secret(),
+			"materialize_sink":                  datasources.Sink(),
+			"materialize_source":                datasources.Source(),
+			"materialize_system_parameters":     datasources.SystemParameters(),
+			"materialize_table":                 datasources.Table(),
+			"materialize_type":                  datasources.Type(),
+			"materialize_view":                  datasources.View(),
 		},
 		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			return providerConfigure(ctx, d, version)
@@ -14,11 +14,13 @@ import (
 )
 
 var (
-	terraformObjectIdRegex       = regexp.MustCompile("^aws/us-east-1:")
-	terraformGrantIdRegex        = regexp.MustCompile("^aws/us-east-1:GRANT|")
-	terraformGrantDefaultIdRegex = regexp.MustCompile("^aws/us-east-1:GRANT DEFAULT|")
-	terraformGrantSystemIdRegex  = regexp.MustCompile("^aws/us-east-1:GRANT ROLE|")
-	terraformGrantRoleIdRegex    = regexp.MustCompile("^aws/us-east-1:GRANT SYSTEM|")
+	terraformObjectIdRegex        = regexp.MustCompile("^aws/us-east-1:")
+	terraformGrantIdRegex         = regexp.MustCompile("^aws/us-east-1:GRANT|")
+	terraformGrantDefaultIdRegex  = regexp.MustCompile("^aws/us-east-1:GRANT DEFAULT|")
+	terraformGrantSystemIdRegex   = regexp.MustCompile("^aws/us-east-1:GRANT ROLE|")
+	terraformGrantRoleIdRegex     = regexp.MustCompile("^aws/us-east-1:GRANT SYSTEM|")
+	terraformRoleParameterIdRegex = regexp.MustCompile("^aws/us-east-1:ROLE PARAMETER|")
+	terraformCommentIdRegex       = regexp.MustCompile("^aws/us-east-1:COMMENT|")
 )
 
 func TestProvider(t *testing.T) {
@@ -1,26 +1,30 @@
 package resources
 
+var webhookValidationPresets = []string{
+	"SEGMENT",
+	"STRIPE",
+	"GITHUB",
+	"HMAC_SHA256",
+}
+
 var loadGeneratorTypes = []string{
 	"AUCTION",
 	"MARKETING",
 	"COUNTER",
 	"TPCH",
+	"KEY VALUE",
+	"CLOCK",
+	"DATUMS",
+}
+
+var resizeStrategies = []string{
+	"immediate",
+	"graceful",
 }
 
-// https://materialize.com/docs/sql/create-cluster-replica/#sizes
-var replicaSizes = []string{
-	"3xsmall",
-	"2xsmall",
-	"xsmall",
-	"small",
-	"medium",
-	"large",
-	"xlarge",
-	"2xlarge",
-	"3xlarge",
-	"4xlarge",
-	"5xlarge",
-	"6xlarge",
+var replacementStrategies = []string{
+	"replace",
+	"create_then_swap",
 }
 
 var saslMechanisms = []string{
@@ -29,16 +33,6 @@ var saslMechanisms = []string{
 	"SCRAM-SHA-512",
 }
 
-var sourceSizes = []string{
-	"3xsmall",
-	"2xsmall",
-	"xsmall",
-	"small",
-	"medium",
-	"large",
-	"xlarge",
-}
-
 var strategy = []string{
 	"INLINE",
 	"ID",
@@ -66,3 +60,11 @@ var securityProtocols = []string{
 	"SSL",
 	"SASL_SSL",
 }
+
+var compressionTypes = []string{
+	"none",
+	"gzip",
+	"snappy",
+	"lz4",
+	"zstd",
+}
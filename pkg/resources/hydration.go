@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type hydrationStatusFunc func() ([]materialize.HydrationStatusParams, error)
+
+// waitForHydration polls the hydration statuses returned by list until every
+// object is hydrated on every replica, or the timeout expires. When
+// requireStatus is set an empty result is not considered hydrated, as the
+// statuses of a new object are only reported once its dataflow is installed.
+func waitForHydration(ctx context.Context, timeout time.Duration, name string, requireStatus bool, list hydrationStatusFunc) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		h, err := list()
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(h) == 0 && requireStatus {
+			return retry.RetryableError(fmt.Errorf("%s has no hydration status yet", name))
+		}
+
+		if lagging := laggingReplicas(h); len(lagging) > 0 {
+			return retry.RetryableError(fmt.Errorf("%s is not hydrated on replicas: %s", name, strings.Join(lagging, ", ")))
+		}
+
+		return nil
+	})
+}
+
+// laggingReplicas lists each replica that has not hydrated all of its objects,
+// with the objects it is still hydrating.
+func laggingReplicas(statuses []materialize.HydrationStatusParams) []string {
+	var replicas []string
+	objects := map[string][]string{}
+	for _, s := range statuses {
+		if s.Hydrated.Bool {
+			continue
+		}
+
+		r := s.ReplicaName.String
+		if _, ok := objects[r]; !ok {
+			replicas = append(replicas, r)
+		}
+		objects[r] = append(objects[r], s.ObjectName.String)
+	}
+
+	var l []string
+	for _, r := range replicas {
+		l = append(l, fmt.Sprintf("%s (%s)", r, strings.Join(objects[r], ", ")))
+	}
+	return l
+}
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/stretchr/testify/require"
+)
+
+func hydrationStatus(replica, object string, hydrated bool) materialize.HydrationStatusParams {
+	return materialize.HydrationStatusParams{
+		ReplicaName: sql.NullString{String: replica, Valid: true},
+		ObjectName:  sql.NullString{String: object, Valid: true},
+		Hydrated:    sql.NullBool{Bool: hydrated, Valid: true},
+	}
+}
+
+func TestLaggingReplicas(t *testing.T) {
+	r := require.New(t)
+	l := laggingReplicas([]materialize.HydrationStatusParams{
+		hydrationStatus("r1", "index", true),
+		hydrationStatus("r1", "mv", true),
+		hydrationStatus("r2", "index", false),
+		hydrationStatus("r2", "mv", false),
+		hydrationStatus("r3", "mv", false),
+	})
+	r.Equal([]string{"r2 (index, mv)", "r3 (mv)"}, l)
+}
+
+func TestWaitForHydration(t *testing.T) {
+	r := require.New(t)
+
+	polls := 0
+	err := waitForHydration(context.TODO(), time.Minute, "index", true, func() ([]materialize.HydrationStatusParams, error) {
+		polls++
+		return []materialize.HydrationStatusParams{hydrationStatus("r1", "index", polls > 1)}, nil
+	})
+	r.NoError(err)
+	r.Equal(2, polls)
+}
+
+func TestWaitForHydrationTimeout(t *testing.T) {
+	r := require.New(t)
+
+	err := waitForHydration(context.TODO(), time.Second, "materialized view mv", true, func() ([]materialize.HydrationStatusParams, error) {
+		return []materialize.HydrationStatusParams{
+			hydrationStatus("r1", "mv", true),
+			hydrationStatus("r2", "mv", false),
+		}, nil
+	})
+	r.ErrorContains(err, "materialized view mv is not hydrated on replicas: r2 (mv)")
+}
+
+func TestWaitForHydrationNoStatus(t *testing.T) {
+	r := require.New(t)
+
+	err := waitForHydration(context.TODO(), time.Minute, "cluster", false, func() ([]materialize.HydrationStatusParams, error) {
+		return nil, nil
+	})
+	r.NoError(err)
+}
@@ -3,13 +3,17 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -26,16 +30,31 @@ var clusterSchema = map[string]*schema.Schema{
 		RequiredWith: []string{"size"},
 	},
 	"disk": DiskSchema(false),
-	// "availability_zones": {
-	// 	Description: "The specific availability zones of the cluster.",
-	// 	Type:        schema.TypeList,
-	// 	Elem:        &schema.Schema{Type: schema.TypeString},
-	// 	Computed:    true,
-	// 	RequiredWith: []string{"size"},
-	// },
+	"availability_zones": {
+		Description:  "The specific availability zones of the managed cluster. Replicas are spread across the zones, so a cluster with fewer replicas than zones only uses a subset of them.",
+		Type:         schema.TypeList,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Optional:     true,
+		Computed:     true,
+		RequiredWith: []string{"size"},
+	},
 	"introspection_interval":        IntrospectionIntervalSchema(false, []string{"size"}),
 	"introspection_debugging":       IntrospectionDebuggingSchema(false, []string{"size"}),
 	"idle_arrangement_merge_effort": IdleArrangementMergeEffortSchema(false, []string{"size"}),
+	"resize_strategy": {
+		Description:  "How to apply a change to `size`. `immediate` (the default) restarts the cluster at the new size. `graceful` creates replicas of the new size alongside the existing ones and only switches once they are hydrated, rolling back if they are not hydrated within the `update` timeout.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "immediate",
+		ValidateFunc: validation.StringInSlice(resizeStrategies, false),
+		RequiredWith: []string{"size"},
+	},
+	"wait_until_ready": {
+		Description: "Wait for every object on the cluster to be hydrated on every replica before completing, after the cluster is created (for up to the `create` timeout) and after changes to its replicas (for up to the `update` timeout). Replicas that are still hydrating are reported if the timeout expires.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
 }
 
 func Cluster() *schema.Resource {
@@ -51,6 +70,13 @@ func Cluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: validSize,
+
 		Schema: clusterSchema,
 	}
 }
@@ -87,6 +113,17 @@ func clusterRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
+	// Replicas only report the zones they run in, which can be a subset of
+	// the configured zones when there are fewer replicas than zones.
+	azs := []string(s.AvailabilityZones)
+	current := materialize.GetSliceValueString(d.Get("availability_zones").([]interface{}))
+	if !subsetOf(azs, current) {
+		sort.Strings(azs)
+		if err := d.Set("availability_zones", azs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := d.Set("comment", s.Comment.String); err != nil {
 		return diag.FromErr(err)
 	}
@@ -113,11 +150,10 @@ func clusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 			b.Disk(v.(bool))
 		}
 
-		// TODO: Disable until supported on create
-		// if v, ok := d.GetOk("availability_zones"); ok {
-		// 	azs := materialize.GetSliceValueString(v.([]interface{}))
-		// 	b.AvailabilityZones(azs)
-		// }
+		if v, ok := d.GetOk("availability_zones"); ok {
+			azs := materialize.GetSliceValueString(v.([]interface{}))
+			b.AvailabilityZones(azs)
+		}
 
 		if v, ok := d.GetOk("introspection_interval"); ok {
 			b.IntrospectionInterval(v.(string))
@@ -166,10 +202,17 @@ func clusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if d.Get("wait_until_ready").(bool) {
+		if err := clusterWait(ctx, meta.(*sqlx.DB), clusterName, i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return clusterRead(ctx, d, meta)
 }
 
 func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	start := time.Now()
 	clusterName := d.Get("name").(string)
 
 	o := materialize.MaterializeObject{ObjectType: "CLUSTER", Name: clusterName}
@@ -186,10 +229,13 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	if _, ok := d.GetOk("size"); ok {
 		if d.HasChange("size") {
 			_, newSize := d.GetChange("size")
-			if err := b.Resize(newSize.(string)); err != nil {
+			if d.Get("resize_strategy").(string) == "graceful" {
+				if err := b.ResizeGraceful(newSize.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+			} else if err := b.Resize(newSize.(string)); err != nil {
 				return diag.FromErr(err)
 			}
-
 		}
 
 		if d.HasChange("disk") {
@@ -206,14 +252,13 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 			}
 		}
 
-		// if d.HasChange("availability_zones") {
-		// 	_, n := d.GetChange("availability_zones")
-		// 	azs := materialize.GetSliceValueString(n.([]interface{}))
-		// 	b := materialize.NewClusterBuilder(meta.(*sqlx.DB), o)
-		// 	if err := b.SetAvailabilityZones(azs); err != nil {
-		// 		return diag.FromErr(err)
-		// 	}
-		// }
+		if d.HasChange("availability_zones") {
+			_, n := d.GetChange("availability_zones")
+			azs := materialize.GetSliceValueString(n.([]interface{}))
+			if err := b.SetAvailabilityZones(azs); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 
 		if d.HasChange("introspection_interval") {
 			_, n := d.GetChange("introspection_interval")
@@ -246,9 +291,44 @@ func clusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if d.Get("wait_until_ready").(bool) && d.HasChanges("wait_until_ready", "size", "replication_factor", "disk", "availability_zones") {
+		// A graceful resize has already spent part of the update timeout
+		// waiting for the new replicas, so only the time left is available.
+		timeout := d.Timeout(schema.TimeoutUpdate) - time.Since(start)
+		if timeout < time.Second {
+			timeout = time.Second
+		}
+		if err := clusterWait(ctx, meta.(*sqlx.DB), clusterName, utils.ExtractId(d.Id()), timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return clusterRead(ctx, d, meta)
 }
 
+func clusterWait(ctx context.Context, conn *sqlx.DB, clusterName, id string, timeout time.Duration) error {
+	name := fmt.Sprintf("cluster %s", clusterName)
+	return waitForHydration(ctx, timeout, name, false, func() ([]materialize.HydrationStatusParams, error) {
+		return materialize.ListClusterHydrationStatuses(conn, id)
+	})
+}
+
+func subsetOf(values, set []string) bool {
+	if len(set) == 0 {
+		return false
+	}
+	m := map[string]bool{}
+	for _, v := range set {
+		m[v] = true
+	}
+	for _, v := range values {
+		if !m[v] {
+			return false
+		}
+	}
+	return true
+}
+
 func clusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterName := d.Get("name").(string)
 
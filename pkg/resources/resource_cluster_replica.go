@@ -46,6 +46,8 @@ func ClusterReplica() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validSize,
+
 		Schema: clusterReplicaSchema,
 	}
 }
@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var clusterSwapSchema = map[string]*schema.Schema{
+	"cluster_name": {
+		Description: "The cluster serving traffic, such as the blue cluster of a blue/green deployment.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"swap_with": {
+		Description: "The cluster to swap with `cluster_name`, such as the green cluster the new version of the objects is deployed to.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"trigger": {
+		Description: "An arbitrary value, such as a release version. The clusters are swapped whenever it changes, but not when the resource is created.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"wait_until_ready": {
+		Description: "Wait for every object on `swap_with` to be hydrated on every replica before swapping, for up to the `update` timeout. Replicas that are still hydrating are reported if the timeout expires, and the clusters are not swapped.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+}
+
+func ClusterSwap() *schema.Resource {
+	return &schema.Resource{
+		Description: "Swaps two clusters with `ALTER CLUSTER ... SWAP WITH` whenever `trigger` changes, to cut over a blue/green deployment in a single change. Resources managing the swapped clusters read them by id and report the other name after a swap, so ignore changes to their names with `lifecycle { ignore_changes = [name] }`.",
+
+		CreateContext: clusterSwapCreate,
+		ReadContext:   clusterSwapRead,
+		UpdateContext: clusterSwapUpdate,
+		DeleteContext: clusterSwapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: clusterSwapSchema,
+	}
+}
+
+type ClusterSwapKey struct {
+	clusterName string
+	swapWith    string
+}
+
+// The clusters are identified by name, as swapping exchanges their ids
+func clusterSwapKey(region, clusterName, swapWith string) string {
+	return fmt.Sprintf(`%[1]s:CLUSTER SWAP|%[2]s|%[3]s`, region, clusterName, swapWith)
+}
+
+func parseClusterSwapKey(id string) (ClusterSwapKey, error) {
+	ie := strings.Split(id, "|")
+
+	if len(ie) != 3 {
+		return ClusterSwapKey{}, fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return ClusterSwapKey{clusterName: ie[1], swapWith: ie[2]}, nil
+}
+
+func clusterSwapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	key, err := parseClusterSwapKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, c := range []string{key.clusterName, key.swapWith} {
+		o := materialize.MaterializeObject{Name: c}
+		if _, err := materialize.ClusterId(meta.(*sqlx.DB), o); err == sql.ErrNoRows {
+			log.Printf("[WARN] cluster (%s) not found, removing cluster swap from state file", c)
+			d.SetId("")
+			return nil
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.TransformIdWithRegion(i))
+
+	if err := d.Set("cluster_name", key.clusterName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("swap_with", key.swapWith); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func clusterSwapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterName := d.Get("cluster_name").(string)
+	swapWith := d.Get("swap_with").(string)
+
+	d.SetId(clusterSwapKey(utils.Region, clusterName, swapWith))
+
+	diags := clusterSwapRead(ctx, d, meta)
+	if !diags.HasError() && d.Id() == "" {
+		return diag.Errorf("clusters %s and %s must exist", clusterName, swapWith)
+	}
+	return diags
+}
+
+func clusterSwapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterName := d.Get("cluster_name").(string)
+	swapWith := d.Get("swap_with").(string)
+
+	if d.HasChange("trigger") {
+		if d.Get("wait_until_ready").(bool) {
+			i, err := materialize.ClusterId(meta.(*sqlx.DB), materialize.MaterializeObject{Name: swapWith})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := clusterWait(ctx, meta.(*sqlx.DB), swapWith, i, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		o := materialize.MaterializeObject{ObjectType: "CLUSTER", Name: clusterName}
+		b := materialize.NewClusterBuilder(meta.(*sqlx.DB), o)
+		if err := b.Swap(swapWith); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return clusterSwapRead(ctx, d, meta)
+}
+
+// Removing the resource leaves the clusters as they are
+func clusterSwapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
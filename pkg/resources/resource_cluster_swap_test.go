@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inClusterSwap = map[string]interface{}{
+	"cluster_name": "blue",
+	"swap_with":    "green",
+	"trigger":      "v1",
+}
+
+func TestResourceClusterSwapCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ClusterSwap().Schema, inClusterSwap)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Creating does not swap, only the clusters are read
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'blue'`)
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'green'`)
+
+		if err := clusterSwapCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:CLUSTER SWAP|blue|green", d.Id())
+	})
+}
+
+func TestResourceClusterSwapUpdateTrigger(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "aws/us-east-1:CLUSTER SWAP|blue|green", Attributes: map[string]string{
+		"id":               "aws/us-east-1:CLUSTER SWAP|blue|green",
+		"cluster_name":     "blue",
+		"swap_with":        "green",
+		"trigger":          "v1",
+		"wait_until_ready": "true",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_name":     "blue",
+		"swap_with":        "green",
+		"trigger":          "v2",
+		"wait_until_ready": true,
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := ClusterSwap().Diff(context.TODO(), state, config, nil)
+		r.NoError(err)
+		d, err := schema.InternalMap(ClusterSwap().Schema).Data(state, diff)
+		r.NoError(err)
+
+		// Wait for the objects on the green cluster to hydrate
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'green'`)
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_cluster_replicas.cluster_id = 'u1'`, true)
+
+		mock.ExpectExec(`ALTER CLUSTER "blue" SWAP WITH "green";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'blue'`)
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'green'`)
+
+		if err := clusterSwapUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceClusterSwapUpdateNoTrigger(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "aws/us-east-1:CLUSTER SWAP|blue|green", Attributes: map[string]string{
+		"id":               "aws/us-east-1:CLUSTER SWAP|blue|green",
+		"cluster_name":     "blue",
+		"swap_with":        "green",
+		"trigger":          "v1",
+		"wait_until_ready": "false",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cluster_name":     "blue",
+		"swap_with":        "green",
+		"trigger":          "v1",
+		"wait_until_ready": true,
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := ClusterSwap().Diff(context.TODO(), state, config, nil)
+		r.NoError(err)
+		d, err := schema.InternalMap(ClusterSwap().Schema).Data(state, diff)
+		r.NoError(err)
+
+		// Only the clusters are read, nothing is swapped
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'blue'`)
+		testhelpers.MockClusterScan(mock, `WHERE mz_clusters.name = 'green'`)
+
+		if err := clusterSwapUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -9,15 +9,16 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
 
 var inCluster = map[string]interface{}{
-	"name":               "cluster",
-	"size":               "3xsmall",
-	"replication_factor": 2,
-	// "availability_zones":            []interface{}{"use1-az1", "use1-az2"},
+	"name":                          "cluster",
+	"size":                          "3xsmall",
+	"replication_factor":            2,
+	"availability_zones":            []interface{}{"use1-az1", "use1-az2", "use1-az3"},
 	"introspection_interval":        "10s",
 	"introspection_debugging":       true,
 	"idle_arrangement_merge_effort": 100,
@@ -36,6 +37,7 @@ func TestResourceClusterCreate(t *testing.T) {
 			CREATE CLUSTER "cluster"
 			SIZE '3xsmall',
 			REPLICATION FACTOR 2,
+			AVAILABILITY ZONES = \['use1-az1','use1-az2','use1-az3'\],
 			INTROSPECTION INTERVAL = '10s',
 			INTROSPECTION DEBUGGING = TRUE,
 			IDLE ARRANGEMENT MERGE EFFORT = 100;
@@ -55,6 +57,9 @@ func TestResourceClusterCreate(t *testing.T) {
 		if err := clusterCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		// Two replicas only report two of the three configured zones
+		r.Equal([]interface{}{"use1-az1", "use1-az2", "use1-az3"}, d.Get("availability_zones"))
 	})
 }
 
@@ -81,6 +86,8 @@ func TestResourceClusterReadIdMigration(t *testing.T) {
 			t.Fatal(err)
 		}
 
+		r.Equal([]interface{}{"use1-az1", "use1-az2"}, d.Get("availability_zones"))
+
 		if d.Id() != "aws/us-east-1:u1" {
 			t.Fatalf("unexpected id of %s", d.Id())
 		}
@@ -135,3 +142,82 @@ func TestResourceClusterDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceClusterUpdateResizeGraceful(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                      "u1",
+		"name":                    "cluster",
+		"size":                    "3xsmall",
+		"replication_factor":      "2",
+		"resize_strategy":         "graceful",
+		"introspection_interval":  "1s",
+		"introspection_debugging": "false",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "cluster",
+		"size":               "xsmall",
+		"replication_factor": 2,
+		"resize_strategy":    "graceful",
+		"timeouts":           map[string]interface{}{"update": "5m"},
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := Cluster().Diff(context.TODO(), state, config, nil)
+		r.NoError(err)
+
+		mock.ExpectExec(
+			`ALTER CLUSTER "cluster" SET \(SIZE = 'xsmall'\) WITH \(WAIT UNTIL READY \(TIMEOUT = '300s', ON TIMEOUT = 'ROLLBACK'\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_clusters.id = 'u1'`
+		testhelpers.MockClusterScan(mock, pp)
+
+		// Apply the diff so the configured update timeout is used
+		if _, diags := Cluster().Apply(context.TODO(), state, diff, db); diags.HasError() {
+			t.Fatal(diags)
+		}
+	})
+}
+
+func TestResourceClusterUpdateWaitUntilReady(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                      "u1",
+		"name":                    "cluster",
+		"size":                    "3xsmall",
+		"replication_factor":      "1",
+		"wait_until_ready":        "true",
+		"introspection_interval":  "1s",
+		"introspection_debugging": "false",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "cluster",
+		"size":               "3xsmall",
+		"replication_factor": 2,
+		"wait_until_ready":   true,
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := Cluster().Diff(context.TODO(), state, config, nil)
+		r.NoError(err)
+
+		mock.ExpectExec(`ALTER CLUSTER "cluster" SET \(REPLICATION FACTOR 2\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Hydration, the new replica is lagging before completing
+		hp := `WHERE mz_cluster_replicas.cluster_id = 'u1'`
+		testhelpers.MockHydrationStatusScan(mock, hp, false)
+		testhelpers.MockHydrationStatusScan(mock, hp, true)
+
+		// Query Params
+		pp := `WHERE mz_clusters.id = 'u1'`
+		testhelpers.MockClusterScan(mock, pp)
+
+		if _, diags := Cluster().Apply(context.TODO(), state, diff, db); diags.HasError() {
+			t.Fatal(diags)
+		}
+	})
+}
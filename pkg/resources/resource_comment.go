@@ -0,0 +1,257 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+var commentObjectTypes = []string{
+	"CLUSTER",
+	"CLUSTER REPLICA",
+	"CONNECTION",
+	"DATABASE",
+	"INDEX",
+	"MATERIALIZED VIEW",
+	"ROLE",
+	"SCHEMA",
+	"SECRET",
+	"SINK",
+	"SOURCE",
+	"TABLE",
+	"TYPE",
+	"VIEW",
+}
+
+var commentSchema = map[string]*schema.Schema{
+	"object_type": {
+		Description:  "The type of the object to comment on.",
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(commentObjectTypes, true),
+		StateFunc: func(v interface{}) string {
+			return strings.ToUpper(v.(string))
+		},
+	},
+	"object_name": {
+		Description: "The name of the object to comment on.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"schema_name": {
+		Description: "The schema of the object. Only applies to objects contained in a schema.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	},
+	"database_name": {
+		Description: "The database of the object. Only applies to schemas and objects contained in a schema.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	},
+	"cluster_name": {
+		Description: "The cluster of the object. Only applies to cluster replicas.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"column_name": {
+		Description: "The column to comment on. If not set the comment is applied to the object itself.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"comment": {
+		Description: "The comment.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+}
+
+func Comment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the comment on any object or column, including objects not managed by Terraform.",
+
+		CreateContext: commentCreate,
+		ReadContext:   commentRead,
+		UpdateContext: commentUpdate,
+		DeleteContext: commentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commentSchema,
+	}
+}
+
+type CommentKey struct {
+	objectType string
+	objectId   string
+	columnName string
+}
+
+func parseCommentKey(id string) (CommentKey, error) {
+	ie := strings.Split(id, "|")
+
+	switch len(ie) {
+	case 3:
+		return CommentKey{objectType: ie[1], objectId: ie[2]}, nil
+	case 4:
+		return CommentKey{objectType: ie[1], objectId: ie[2], columnName: ie[3]}, nil
+	}
+
+	return CommentKey{}, fmt.Errorf("%s cannot be parsed correctly", id)
+}
+
+func commentKey(region, objectType, objectId, columnName string) string {
+	k := fmt.Sprintf(`%[1]s:COMMENT|%[2]s|%[3]s`, region, materialize.CommentObjectType(objectType), objectId)
+	if columnName != "" {
+		k += fmt.Sprintf(`|%s`, columnName)
+	}
+	return k
+}
+
+func commentObject(d *schema.ResourceData) materialize.MaterializeObject {
+	return materialize.MaterializeObject{
+		ObjectType:   strings.ToUpper(d.Get("object_type").(string)),
+		Name:         d.Get("object_name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+		ClusterName:  d.Get("cluster_name").(string),
+	}
+}
+
+func commentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	key, err := parseCommentKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanComment(meta.(*sqlx.DB), key.objectType, key.objectId, key.columnName)
+	if err == sql.ErrNoRows {
+		log.Printf("[WARN] comment (%s) not found, removing from state file", i)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(i))
+
+	objectType := strings.ToUpper(strings.ReplaceAll(s.ObjectType.String, "-", " "))
+	if err := d.Set("object_type", objectType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("object_name", s.ObjectName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", s.SchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("database_name", s.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("cluster_name", s.ClusterName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("column_name", s.ColumnName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func commentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o := commentObject(d)
+	columnName := d.Get("column_name").(string)
+	comment := d.Get("comment").(string)
+
+	b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+	if columnName != "" {
+		if err := b.Column(columnName, comment); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := b.Object(comment); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.ObjectId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(commentKey(utils.Region, o.ObjectType, i, columnName))
+
+	return commentRead(ctx, d, meta)
+}
+
+func commentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o := commentObject(d)
+	columnName := d.Get("column_name").(string)
+
+	if d.HasChange("comment") {
+		_, newComment := d.GetChange("comment")
+		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if columnName != "" {
+			if err := b.Column(columnName, newComment.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := b.Object(newComment.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return commentRead(ctx, d, meta)
+}
+
+func commentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o := commentObject(d)
+	columnName := d.Get("column_name").(string)
+
+	b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+	if columnName != "" {
+		if err := b.ResetColumn(columnName); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if err := b.ResetObject(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inComment = map[string]interface{}{
+	"object_type":   "TABLE",
+	"object_name":   "table",
+	"schema_name":   "schema",
+	"database_name": "database",
+	"comment":       "comment",
+}
+
+func TestResourceCommentCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inComment)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."table" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockTableScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE comments.id = 'u1' AND comments.object_sub_id IS NULL AND comments.object_type = 'table'`
+		testhelpers.MockCommentScan(mock, pp)
+
+		if err := commentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Id() != "aws/us-east-1:COMMENT|table|u1" {
+			t.Fatalf("unexpected id of %s", d.Id())
+		}
+	})
+}
+
+func TestResourceCommentColumnCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"object_type":   "TABLE",
+		"object_name":   "table",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"column_name":   "column",
+		"comment":       "comment",
+	}
+	d := schema.TestResourceDataRaw(t, Comment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."table"."column" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockTableScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE comments.id = 'u1' AND comments.object_type = 'table' AND mz_columns.name = 'column'`
+		testhelpers.MockCommentScan(mock, pp)
+
+		if err := commentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Id() != "aws/us-east-1:COMMENT|table|u1|column" {
+			t.Fatalf("unexpected id of %s", d.Id())
+		}
+	})
+}
+
+func TestResourceCommentImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	d.SetId("aws/us-east-1:COMMENT|table|u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE comments.id = 'u1' AND comments.object_sub_id IS NULL AND comments.object_type = 'table'`
+		testhelpers.MockCommentScan(mock, pp)
+
+		if err := commentRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("TABLE", d.Get("object_type"))
+		r.Equal("table", d.Get("object_name"))
+		r.Equal("schema", d.Get("schema_name"))
+		r.Equal("database", d.Get("database_name"))
+		r.Equal("comment", d.Get("comment"))
+	})
+}
+
+func TestResourceCommentDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inComment)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."table" IS NULL;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := commentDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -90,6 +90,49 @@ func connectionUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 	return connectionRead(ctx, d, meta)
 }
 
+// alterableConnectionSchema marks a connection attribute, including the
+// attributes nested within it, as updated in place with ALTER CONNECTION
+// rather than forcing replacement.
+func alterableConnectionSchema(s *schema.Schema) *schema.Schema {
+	s.ForceNew = false
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, n := range r.Schema {
+			alterableConnectionSchema(n)
+		}
+	}
+	return s
+}
+
+func connectionValueSecret(d *schema.ResourceData, key string) materialize.ValueSecretStruct {
+	if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 {
+		return materialize.GetValueSecretStruct(v)
+	}
+	return materialize.ValueSecretStruct{}
+}
+
+func connectionIdentifier(d *schema.ResourceData, key string) materialize.IdentifierSchemaStruct {
+	if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 {
+		return materialize.GetIdentifierSchemaStruct(v)
+	}
+	return materialize.IdentifierSchemaStruct{}
+}
+
+// connectionAlter applies changed connection options before any rename, so
+// the connection is addressed by its previous name.
+func connectionAlter(d *schema.ResourceData, meta interface{}, options []materialize.ConnectionOption) error {
+	if len(options) == 0 {
+		return nil
+	}
+
+	oldName, _ := d.GetChange("name")
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "CONNECTION", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewConnection(meta.(*sqlx.DB), o)
+	return b.Alter(options, d.Get("validate").(bool))
+}
+
 func connectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	connectionName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -3,12 +3,15 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 )
@@ -33,11 +36,22 @@ var connectionAwsPrivatelinkSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	},
 	"principal": {
-		Description: "The principal of the AWS PrivateLink service.",
+		Description: "The AWS principal of the connection, to allow on the AWS PrivateLink endpoint service.",
 		Type:        schema.TypeString,
 		Computed:    true,
 		Sensitive:   true,
 	},
+	"status": {
+		Description: "The status of the AWS PrivateLink endpoint, for example `pending-service-discovery`, `pending-acceptance` or `available`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"wait_for_available": {
+		Description: "Wait for the AWS PrivateLink endpoint to become `available` before completing, for up to the `create` timeout. Connections that depend on this connection are only created once the link is up.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
 	"ownership_role": OwnershipRoleSchema(),
 }
 
@@ -54,6 +68,10 @@ func ConnectionAwsPrivatelink() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: connectionAwsPrivatelinkSchema,
 	}
 }
@@ -94,6 +112,10 @@ func connectionAwsPrivatelinkRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("status", s.Status.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
 		return diag.FromErr(err)
 	}
@@ -161,6 +183,12 @@ func connectionAwsPrivatelinkCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if d.Get("wait_for_available").(bool) {
+		if err := connectionAwsPrivatelinkWait(ctx, meta.(*sqlx.DB), i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return connectionAwsPrivatelinkRead(ctx, d, meta)
 }
 
@@ -197,5 +225,37 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("wait_for_available") && d.Get("wait_for_available").(bool) {
+		if err := connectionAwsPrivatelinkWait(ctx, meta.(*sqlx.DB), utils.ExtractId(d.Id()), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return connectionAwsPrivatelinkRead(ctx, d, meta)
 }
+
+// AWS PrivateLink endpoint statuses that will not become available without
+// intervention.
+var privatelinkFailedStatuses = []string{"deleted", "expired", "failed", "rejected"}
+
+func connectionAwsPrivatelinkWait(ctx context.Context, conn *sqlx.DB, id string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		s, err := materialize.ScanConnectionAwsPrivatelink(conn, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		status := s.Status.String
+		if status == "available" {
+			return nil
+		}
+
+		for _, f := range privatelinkFailedStatuses {
+			if status == f {
+				return retry.NonRetryableError(fmt.Errorf("AWS PrivateLink connection %s is %s", s.ConnectionName.String, status))
+			}
+		}
+
+		return retry.RetryableError(fmt.Errorf("AWS PrivateLink connection %s is %s, waiting for available", s.ConnectionName.String, status))
+	})
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 
@@ -47,6 +48,59 @@ func TestResourceConnectionAwsPrivatelinkCreate(t *testing.T) {
 
 }
 
+func TestResourceConnectionAwsPrivatelinkCreateWaitForAvailable(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":               "conn",
+		"schema_name":        "schema",
+		"database_name":      "database",
+		"service_name":       "service",
+		"availability_zones": []interface{}{"use1-az1", "use1-az2"},
+		"wait_for_available": true,
+	}
+	d := schema.TestResourceDataRaw(t, ConnectionAwsPrivatelink().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."conn"
+			TO AWS PRIVATELINK \(SERVICE NAME 'service',AVAILABILITY ZONES \('use1-az1', 'use1-az2'\)\)`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_connections.name = 'conn' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Wait for available
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsPrivatelinkStatusScan(mock, pp, "pending-service-discovery")
+		testhelpers.MockConnectionAwsPrivatelinkStatusScan(mock, pp, "available")
+
+		// Query Params
+		testhelpers.MockConnectionAwsPrivatelinkScan(mock, pp)
+
+		if err := connectionAwsPrivatelinkCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("available", d.Get("status"))
+		r.Equal("principal", d.Get("principal"))
+	})
+}
+
+func TestResourceConnectionAwsPrivatelinkWaitFailed(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsPrivatelinkStatusScan(mock, pp, "rejected")
+
+		err := connectionAwsPrivatelinkWait(context.TODO(), db, "u1", time.Minute)
+		r.ErrorContains(err, "AWS PrivateLink connection connection is rejected")
+	})
+}
+
 // Confirm id is updated with region for 0.4.0
 func TestResourceConnectionAwsPrivatelinkReadIdMigration(t *testing.T) {
 	r := require.New(t)
@@ -23,24 +23,24 @@ var connectionConfluentSchemaRegistrySchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 	},
-	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Confluent Schema Registry.", false),
-	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the Confluent Schema Registry.", false),
-	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the Confluent Schema Registry.", false),
-	"password":                  IdentifierSchema("password", "The password for the Confluent Schema Registry.", false),
-	"username":                  ValueSecretSchema("username", "The username for the Confluent Schema Registry.", false),
-	"ssh_tunnel":                IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Confluent Schema Registry.", false),
-	"aws_privatelink":           IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Confluent Schema Registry.", false),
+	"ssl_certificate_authority": alterableConnectionSchema(ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Confluent Schema Registry.", false)),
+	"ssl_certificate":           alterableConnectionSchema(ValueSecretSchema("ssl_certificate", "The client certificate for the Confluent Schema Registry.", false)),
+	"ssl_key":                   alterableConnectionSchema(IdentifierSchema("ssl_key", "The client key for the Confluent Schema Registry.", false)),
+	"password":                  alterableConnectionSchema(IdentifierSchema("password", "The password for the Confluent Schema Registry.", false)),
+	"username":                  alterableConnectionSchema(ValueSecretSchema("username", "The username for the Confluent Schema Registry.", false)),
+	"ssh_tunnel":                alterableConnectionSchema(IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Confluent Schema Registry.", false)),
+	"aws_privatelink":           alterableConnectionSchema(IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Confluent Schema Registry.", false)),
 	"validate":                  ValidateConnectionSchema(),
 	"ownership_role":            OwnershipRoleSchema(),
 }
 
 func ConnectionConfluentSchemaRegistry() *schema.Resource {
 	return &schema.Resource{
-		Description: "A Confluent Schema Registry connection establishes a link to a Confluent Schema Registry server.",
+		Description: "A Confluent Schema Registry connection establishes a link to a Confluent Schema Registry server. Changes are applied in place with `ALTER CONNECTION`.",
 
 		CreateContext: connectionConfluentSchemaRegistryCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: connectionConfluentSchemaRegistryUpdate,
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -138,3 +138,49 @@ func connectionConfluentSchemaRegistryCreate(ctx context.Context, d *schema.Reso
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionConfluentSchemaRegistryOptions(d *schema.ResourceData) []materialize.ConnectionOption {
+	var options []materialize.ConnectionOption
+
+	if d.HasChange("url") {
+		options = append(options, materialize.StringOption("URL", d.Get("url").(string)))
+	}
+
+	if d.HasChange("username") {
+		options = append(options, materialize.ValueSecretOption("USERNAME", connectionValueSecret(d, "username")))
+	}
+
+	if d.HasChange("password") {
+		options = append(options, materialize.SecretOption("PASSWORD", connectionIdentifier(d, "password")))
+	}
+
+	if d.HasChange("ssl_certificate_authority") {
+		options = append(options, materialize.ValueSecretOption("SSL CERTIFICATE AUTHORITY", connectionValueSecret(d, "ssl_certificate_authority")))
+	}
+
+	if d.HasChange("ssl_certificate") {
+		options = append(options, materialize.ValueSecretOption("SSL CERTIFICATE", connectionValueSecret(d, "ssl_certificate")))
+	}
+
+	if d.HasChange("ssl_key") {
+		options = append(options, materialize.SecretOption("SSL KEY", connectionIdentifier(d, "ssl_key")))
+	}
+
+	if d.HasChange("aws_privatelink") {
+		options = append(options, materialize.IdentifierOption("AWS PRIVATELINK", connectionIdentifier(d, "aws_privatelink")))
+	}
+
+	if d.HasChange("ssh_tunnel") {
+		options = append(options, materialize.IdentifierOption("SSH TUNNEL", connectionIdentifier(d, "ssh_tunnel")))
+	}
+
+	return options
+}
+
+func connectionConfluentSchemaRegistryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := connectionAlter(d, meta, connectionConfluentSchemaRegistryOptions(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return connectionUpdate(ctx, d, meta)
+}
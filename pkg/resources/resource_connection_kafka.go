@@ -25,7 +25,6 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Required:    true,
 		MinItems:    1,
-		ForceNew:    true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"broker": {
@@ -43,8 +42,8 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
 				},
-				"privatelink_connection": IdentifierSchema("privatelink_connection", "The AWS PrivateLink connection name in Materialize.", false),
-				"ssh_tunnel":             IdentifierSchema("ssh_tunnel", "The name of an SSH tunnel connection to route network traffic through by default.", false),
+				"privatelink_connection": alterableConnectionSchema(IdentifierSchema("privatelink_connection", "The AWS PrivateLink connection name in Materialize.", false)),
+				"ssh_tunnel":             alterableConnectionSchema(IdentifierSchema("ssh_tunnel", "The name of an SSH tunnel connection to route network traffic through by default.", false)),
 			},
 		},
 	},
@@ -52,21 +51,20 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Description:  "The security protocol to use: `PLAINTEXT`, `SSL`, `SASL_PLAINTEXT`, or `SASL_SSL`.",
 		Type:         schema.TypeString,
 		Optional:     true,
-		ForceNew:     true,
 		ValidateFunc: validation.StringInSlice(securityProtocols, true),
 		StateFunc: func(val any) string {
 			return strings.ToUpper(val.(string))
 		},
 	},
 	"progress_topic": {
-		Description: "The name of a topic that Kafka sinks can use to track internal consistency metadata.",
+		Description: "The name of a topic that Kafka sinks can use to track internal consistency metadata. Changing the progress topic forces a new connection.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Kafka broker.", false),
-	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the Kafka broker.", false),
-	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the Kafka broker.", false),
+	"ssl_certificate_authority": alterableConnectionSchema(ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Kafka broker.", false)),
+	"ssl_certificate":           alterableConnectionSchema(ValueSecretSchema("ssl_certificate", "The client certificate for the Kafka broker.", false)),
+	"ssl_key":                   alterableConnectionSchema(IdentifierSchema("ssl_key", "The client key for the Kafka broker.", false)),
 	"sasl_mechanisms": {
 		Description:  "The SASL mechanism for the Kafka broker.",
 		Type:         schema.TypeString,
@@ -76,22 +74,21 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		StateFunc: func(val any) string {
 			return strings.ToUpper(val.(string))
 		},
-		ForceNew: true,
 	},
-	"sasl_username":  ValueSecretSchema("sasl_username", "The SASL username for the Kafka broker.", false),
-	"sasl_password":  IdentifierSchema("sasl_password", "The SASL password for the Kafka broker.", false),
-	"ssh_tunnel":     IdentifierSchema("ssh_tunnel", "The default SSH tunnel configuration for the Kafka brokers.", false),
+	"sasl_username":  alterableConnectionSchema(ValueSecretSchema("sasl_username", "The SASL username for the Kafka broker.", false)),
+	"sasl_password":  alterableConnectionSchema(IdentifierSchema("sasl_password", "The SASL password for the Kafka broker.", false)),
+	"ssh_tunnel":     alterableConnectionSchema(IdentifierSchema("ssh_tunnel", "The default SSH tunnel configuration for the Kafka brokers.", false)),
 	"validate":       ValidateConnectionSchema(),
 	"ownership_role": OwnershipRoleSchema(),
 }
 
 func ConnectionKafka() *schema.Resource {
 	return &schema.Resource{
-		Description: "A Kafka connection establishes a link to a Kafka cluster.",
+		Description: "A Kafka connection establishes a link to a Kafka cluster. Changes to brokers, security and SSH tunnel settings are applied in place with `ALTER CONNECTION`.",
 
 		CreateContext: connectionKafkaCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: connectionKafkaUpdate,
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -197,3 +194,54 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionKafkaOptions(d *schema.ResourceData) []materialize.ConnectionOption {
+	var options []materialize.ConnectionOption
+
+	if d.HasChange("kafka_broker") {
+		brokers := materialize.GetKafkaBrokersStruct(d.Get("kafka_broker"))
+		options = append(options, materialize.KafkaBrokersOption(brokers))
+	}
+
+	if d.HasChange("ssh_tunnel") {
+		options = append(options, materialize.IdentifierOption("SSH TUNNEL", connectionIdentifier(d, "ssh_tunnel")))
+	}
+
+	if d.HasChange("security_protocol") {
+		options = append(options, materialize.StringOption("SECURITY PROTOCOL", d.Get("security_protocol").(string)))
+	}
+
+	if d.HasChange("ssl_certificate_authority") {
+		options = append(options, materialize.ValueSecretOption("SSL CERTIFICATE AUTHORITY", connectionValueSecret(d, "ssl_certificate_authority")))
+	}
+
+	if d.HasChange("ssl_certificate") {
+		options = append(options, materialize.ValueSecretOption("SSL CERTIFICATE", connectionValueSecret(d, "ssl_certificate")))
+	}
+
+	if d.HasChange("ssl_key") {
+		options = append(options, materialize.SecretOption("SSL KEY", connectionIdentifier(d, "ssl_key")))
+	}
+
+	if d.HasChange("sasl_mechanisms") {
+		options = append(options, materialize.StringOption("SASL MECHANISMS", d.Get("sasl_mechanisms").(string)))
+	}
+
+	if d.HasChange("sasl_username") {
+		options = append(options, materialize.ValueSecretOption("SASL USERNAME", connectionValueSecret(d, "sasl_username")))
+	}
+
+	if d.HasChange("sasl_password") {
+		options = append(options, materialize.SecretOption("SASL PASSWORD", connectionIdentifier(d, "sasl_password")))
+	}
+
+	return options
+}
+
+func connectionKafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := connectionAlter(d, meta, connectionKafkaOptions(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return connectionUpdate(ctx, d, meta)
+}
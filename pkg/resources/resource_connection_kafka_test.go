@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -77,3 +78,53 @@ func TestResourceConnectionKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionKafkaUpdate(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                    "u1",
+		"name":                  "old_conn",
+		"schema_name":           "schema",
+		"database_name":         "database",
+		"kafka_broker.#":        "1",
+		"kafka_broker.0.broker": "b-1:9092",
+		"security_protocol":     "PLAINTEXT",
+		"progress_topic":        "topic",
+		"validate":              "true",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":              "conn",
+		"schema_name":       "schema",
+		"database_name":     "database",
+		"kafka_broker":      []interface{}{map[string]interface{}{"broker": "b-1:9092"}, map[string]interface{}{"broker": "b-2:9092"}},
+		"security_protocol": "SASL_SSL",
+		"progress_topic":    "topic",
+		"sasl_mechanisms":   "PLAIN",
+		"sasl_username":     []interface{}{map[string]interface{}{"text": "user"}},
+		"sasl_password":     []interface{}{map[string]interface{}{"name": "password"}},
+		"validate":          false,
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := ConnectionKafka().Diff(context.TODO(), state, config, db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+
+		d, err := schema.InternalMap(ConnectionKafka().Schema).Data(state, diff)
+		r.NoError(err)
+
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."old_conn" SET \(BROKERS = \('b-1:9092', 'b-2:9092'\)\), SET \(SECURITY PROTOCOL = 'SASL_SSL'\), SET \(SASL MECHANISMS = 'PLAIN'\), SET \(SASL USERNAME = 'user'\), SET \(SASL PASSWORD = SECRET "materialize"."public"."password"\) WITH \(VALIDATE = false\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."old_conn" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		if err := connectionKafkaUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
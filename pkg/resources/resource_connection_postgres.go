@@ -19,7 +19,7 @@ var connectionPostgresSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("connection"),
 	"comment":            CommentSchema(false),
 	"database": {
-		Description: "The target Postgres database.",
+		Description: "The target Postgres database. Changing the database forces a new connection.",
 		Type:        schema.TypeString,
 		Required:    true,
 		ForceNew:    true,
@@ -28,39 +28,36 @@ var connectionPostgresSchema = map[string]*schema.Schema{
 		Description: "The Postgres database hostname.",
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"port": {
 		Description: "The Postgres database port.",
 		Type:        schema.TypeInt,
 		Optional:    true,
 		Default:     5432,
-		ForceNew:    true,
 	},
-	"user":                      ValueSecretSchema("user", "The Postgres database username.", true),
-	"password":                  IdentifierSchema("password", "The Postgres database password.", false),
-	"ssh_tunnel":                IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Postgres database.", false),
-	"ssl_certificate_authority": ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Postgres database.", false),
-	"ssl_certificate":           ValueSecretSchema("ssl_certificate", "The client certificate for the Postgres database.", false),
-	"ssl_key":                   IdentifierSchema("ssl_key", "The client key for the Postgres database.", false),
+	"user":                      alterableConnectionSchema(ValueSecretSchema("user", "The Postgres database username.", true)),
+	"password":                  alterableConnectionSchema(IdentifierSchema("password", "The Postgres database password.", false)),
+	"ssh_tunnel":                alterableConnectionSchema(IdentifierSchema("ssh_tunnel", "The SSH tunnel configuration for the Postgres database.", false)),
+	"ssl_certificate_authority": alterableConnectionSchema(ValueSecretSchema("ssl_certificate_authority", "The CA certificate for the Postgres database.", false)),
+	"ssl_certificate":           alterableConnectionSchema(ValueSecretSchema("ssl_certificate", "The client certificate for the Postgres database.", false)),
+	"ssl_key":                   alterableConnectionSchema(IdentifierSchema("ssl_key", "The client key for the Postgres database.", false)),
 	"ssl_mode": {
 		Description: "The SSL mode for the Postgres database.",
 		Type:        schema.TypeString,
 		Optional:    true,
-		ForceNew:    true,
 	},
-	"aws_privatelink": IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Postgres database.", false),
+	"aws_privatelink": alterableConnectionSchema(IdentifierSchema("aws_privatelink", "The AWS PrivateLink configuration for the Postgres database.", false)),
 	"validate":        ValidateConnectionSchema(),
 	"ownership_role":  OwnershipRoleSchema(),
 }
 
 func ConnectionPostgres() *schema.Resource {
 	return &schema.Resource{
-		Description: "A Postgres connection establishes a link to a single database of a PostgreSQL server.",
+		Description: "A Postgres connection establishes a link to a single database of a PostgreSQL server. Changes other than the target database are applied in place with `ALTER CONNECTION`.",
 
 		CreateContext: connectionPostgresCreate,
 		ReadContext:   connectionRead,
-		UpdateContext: connectionUpdate,
+		UpdateContext: connectionPostgresUpdate,
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -174,3 +171,57 @@ func connectionPostgresCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionPostgresOptions(d *schema.ResourceData) []materialize.ConnectionOption {
+	var options []materialize.ConnectionOption
+
+	if d.HasChange("host") {
+		options = append(options, materialize.StringOption("HOST", d.Get("host").(string)))
+	}
+
+	if d.HasChange("port") {
+		options = append(options, materialize.IntOption("PORT", d.Get("port").(int)))
+	}
+
+	if d.HasChange("user") {
+		options = append(options, materialize.ValueSecretOption("USER", connectionValueSecret(d, "user")))
+	}
+
+	if d.HasChange("password") {
+		options = append(options, materialize.SecretOption("PASSWORD", connectionIdentifier(d, "password")))
+	}
+
+	if d.HasChange("ssl_mode") {
+		options = append(options, materialize.StringOption("SSL MODE", d.Get("ssl_mode").(string)))
+	}
+
+	if d.HasChange("ssh_tunnel") {
+		options = append(options, materialize.IdentifierOption("SSH TUNNEL", connectionIdentifier(d, "ssh_tunnel")))
+	}
+
+	if d.HasChange("ssl_certificate_authority") {
+		options = append(options, materialize.ValueSecretOption("SSL CERTIFICATE AUTHORITY", connectionValueSecret(d, "ssl_certificate_authority")))
+	}
+
+	if d.HasChange("ssl_certificate") {
+		options = append(options, materialize.ValueSecretOption("SSL CERTIFICATE", connectionValueSecret(d, "ssl_certificate")))
+	}
+
+	if d.HasChange("ssl_key") {
+		options = append(options, materialize.SecretOption("SSL KEY", connectionIdentifier(d, "ssl_key")))
+	}
+
+	if d.HasChange("aws_privatelink") {
+		options = append(options, materialize.IdentifierOption("AWS PRIVATELINK", connectionIdentifier(d, "aws_privatelink")))
+	}
+
+	return options
+}
+
+func connectionPostgresUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := connectionAlter(d, meta, connectionPostgresOptions(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return connectionUpdate(ctx, d, meta)
+}
@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -70,3 +71,97 @@ func TestResourceConnectionPostgresCreate(t *testing.T) {
 		}
 	})
 }
+
+func connectionPostgresState() *terraform.InstanceState {
+	return &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                         "u1",
+		"name":                       "conn",
+		"schema_name":                "schema",
+		"database_name":              "database",
+		"database":                   "default",
+		"host":                       "postgres_host",
+		"port":                       "5432",
+		"user.#":                     "1",
+		"user.0.text":                "postgres",
+		"user.0.secret.#":            "0",
+		"ssh_tunnel.#":               "1",
+		"ssh_tunnel.0.name":          "ssh_conn",
+		"ssh_tunnel.0.schema_name":   "public",
+		"ssh_tunnel.0.database_name": "materialize",
+		"validate":                   "true",
+	}}
+}
+
+func TestResourceConnectionPostgresUpdate(t *testing.T) {
+	r := require.New(t)
+
+	state := connectionPostgresState()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "conn",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"database":      "default",
+		"host":          "postgres_replica",
+		"port":          5433,
+		"user":          []interface{}{map[string]interface{}{"text": "postgres"}},
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := ConnectionPostgres().Diff(context.TODO(), state, config, db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+
+		d, err := schema.InternalMap(ConnectionPostgres().Schema).Data(state, diff)
+		r.NoError(err)
+
+		mock.ExpectExec(
+			`ALTER CONNECTION "database"."schema"."conn" SET \(HOST = 'postgres_replica'\), SET \(PORT = 5433\), RESET \(SSH TUNNEL\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		if err := connectionPostgresUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceConnectionPostgresUpdateUserSecret(t *testing.T) {
+	r := require.New(t)
+
+	// Switching the user from text to a secret is applied in place
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "conn",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"database":      "default",
+		"host":          "postgres_host",
+		"user":          []interface{}{map[string]interface{}{"secret": []interface{}{map[string]interface{}{"name": "user"}}}},
+		"ssh_tunnel":    []interface{}{map[string]interface{}{"name": "ssh_conn"}},
+	})
+
+	diff, err := ConnectionPostgres().Diff(context.TODO(), connectionPostgresState(), config, nil)
+	r.NoError(err)
+	r.NotNil(diff)
+	r.False(diff.RequiresNew())
+}
+
+func TestResourceConnectionPostgresUpdateDatabaseForceNew(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "conn",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"database":      "other",
+		"host":          "postgres_host",
+		"user":          []interface{}{map[string]interface{}{"text": "postgres"}},
+		"ssh_tunnel":    []interface{}{map[string]interface{}{"name": "ssh_conn"}},
+	})
+
+	diff, err := ConnectionPostgres().Diff(context.TODO(), connectionPostgresState(), config, nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+}
@@ -47,6 +47,11 @@ var connectionSshTunnelSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
+	"rotation_trigger": {
+		Description: "An arbitrary value that, when changed, rotates the SSH key pairs of the tunnel with `ALTER CONNECTION ... ROTATE KEYS`. Both `public_key_1` and `public_key_2` are refreshed in the same apply.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
 	"ownership_role": OwnershipRoleSchema(),
 }
 
@@ -63,10 +68,25 @@ func ConnectionSshTunnel() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: connectionSshTunnelRotationDiff,
+
 		Schema: connectionSshTunnelSchema,
 	}
 }
 
+// connectionSshTunnelRotationDiff marks both public keys as unknown when the
+// rotation trigger changes, so dependents see the rotated keys in the plan.
+func connectionSshTunnelRotationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("rotation_trigger") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("public_key_1"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("public_key_2")
+}
+
 func connectionSshTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	i := d.Id()
 
@@ -181,6 +201,13 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("rotation_trigger") {
+		b := materialize.NewConnectionSshTunnelBuilder(meta.(*sqlx.DB), o)
+		if err := b.RotateKeys(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
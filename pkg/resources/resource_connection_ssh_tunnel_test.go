@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -101,3 +102,50 @@ func TestResourceConnectionSshTunnelUpdate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionSshTunnelRotateKeys(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":               "u1",
+		"name":             "conn",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"host":             "localhost",
+		"port":             "123",
+		"user":             "user",
+		"public_key_1":     "key_1",
+		"public_key_2":     "key_2",
+		"rotation_trigger": "2024-01",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "conn",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"host":             "localhost",
+		"port":             123,
+		"user":             "user",
+		"rotation_trigger": "2024-02",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := ConnectionSshTunnel().Diff(context.TODO(), state, config, db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+		r.True(diff.Attributes["public_key_1"].NewComputed)
+		r.True(diff.Attributes["public_key_2"].NewComputed)
+
+		d, err := schema.InternalMap(ConnectionSshTunnel().Schema).Data(state, diff)
+		r.NoError(err)
+
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."conn" ROTATE KEYS;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionSshTunnelScan(mock, pp)
+
+		if err := connectionSshTunnelUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
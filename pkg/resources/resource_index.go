@@ -3,7 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -71,6 +73,7 @@ var indexSchema = map[string]*schema.Schema{
 		Required: true,
 		ForceNew: true,
 	},
+	"wait_until_ready": WaitUntilReadySchema("index"),
 }
 
 func Index() *schema.Resource {
@@ -86,6 +89,10 @@ func Index() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: indexSchema,
 	}
 }
@@ -189,12 +196,19 @@ func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	// set id
-	i, err := materialize.IndexId(meta.(*sqlx.DB), indexName)
+	io := materialize.MaterializeObject{Name: indexName, SchemaName: obj["schema_name"].(string), DatabaseName: obj["database_name"].(string)}
+	i, err := materialize.IndexId(meta.(*sqlx.DB), io)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if d.Get("wait_until_ready").(bool) {
+		if err := indexWait(ctx, meta.(*sqlx.DB), d, i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return indexRead(ctx, d, meta)
 }
 
@@ -211,9 +225,27 @@ func indexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("wait_until_ready") && d.Get("wait_until_ready").(bool) {
+		if err := indexWait(ctx, meta.(*sqlx.DB), d, utils.ExtractId(d.Id()), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return indexRead(ctx, d, meta)
 }
 
+func indexWait(ctx context.Context, conn *sqlx.DB, d *schema.ResourceData, id string, timeout time.Duration) error {
+	name := fmt.Sprintf("index %s", d.Get("name").(string))
+	if d.Get("default").(bool) {
+		obj := d.Get("obj_name").([]interface{})[0].(map[string]interface{})
+		name = fmt.Sprintf("default index on %s", obj["name"].(string))
+	}
+
+	return waitForHydration(ctx, timeout, name, true, func() ([]materialize.HydrationStatusParams, error) {
+		return materialize.ListObjectHydrationStatuses(conn, id)
+	})
+}
+
 func indexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	obj := d.Get("obj_name").([]interface{})[0].(map[string]interface{})
 	name := d.Get("name").(string)
@@ -31,7 +31,7 @@ func TestResourceIndexCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Query Id
-		ip := `WHERE mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
 		testhelpers.MockIndexScan(mock, ip)
 
 		// Query Params
@@ -48,6 +48,48 @@ func TestResourceIndexCreate(t *testing.T) {
 	})
 }
 
+func TestResourceIndexCreateWaitUntilReady(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":             "index",
+		"default":          false,
+		"obj_name":         []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name":     "cluster",
+		"wait_until_ready": true,
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE INDEX index IN CLUSTER cluster ON "database"."schema"."source" USING ARRANGEMENT \(\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockIndexScan(mock, ip)
+
+		// Hydration, lagging on the second replica before completing
+		hp := `WHERE mz_hydration_statuses.object_id = 'u1'`
+		testhelpers.MockHydrationStatusScan(mock, hp, false)
+		testhelpers.MockHydrationStatusScan(mock, hp, true)
+
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Columns
+		cp := `WHERE mz_indexes.id = 'u1'`
+		testhelpers.MockIndexColumnScan(mock, cp)
+
+		if err := indexCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 // Confirm id is updated with region for 0.4.0
 func TestResourceIndexReadIdMigration(t *testing.T) {
 	r := require.New(t)
@@ -3,13 +3,17 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -29,19 +33,26 @@ var materializedViewSchema = map[string]*schema.Schema{
 		Computed:    true,
 	},
 	"not_null_assertion": {
-		Description: "**Private Preview** A list of columns for which to create non-null assertions.",
+		Description: "**Private Preview** A list of columns for which to create non-null assertions. Changes are applied according to `replacement_strategy`.",
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		ForceNew:    true,
 	},
 	"statement": {
-		Description: "The SQL statement for the materialized view.",
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
+		Description:      "The SQL statement for the materialized view. Changes are applied according to `replacement_strategy`. Differences in formatting, casing, qualification and type aliases from the definition stored by Materialize are ignored. The statement is only read from the stored definition on import, so changes made outside of Terraform are logged rather than reported in the plan.",
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: statementDiffSuppress,
+	},
+	"replacement_strategy": {
+		Description:  "How to apply a change to `statement` or `not_null_assertion`. `replace` (the default) drops and recreates the materialized view. `create_then_swap` builds the new definition under a shadow name on the same cluster, waits for it to hydrate for up to the `update` timeout, retargets the sinks reading from the materialized view with `ALTER SINK ... SET FROM` and then swaps it into place. The shadow is granted the privileges of the materialized view, and the previous materialized view is renamed out of the way in the same transaction and only dropped once the shadow is in place. Indexes, views and other objects depending on the materialized view are not recreated on the shadow, so while any objects other than sinks depend on it the change replaces the materialized view instead, as with `replace`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "replace",
+		ValidateFunc: validation.StringInSlice(replacementStrategies, false),
 	},
-	"ownership_role": OwnershipRoleSchema(),
+	"ownership_role":   OwnershipRoleSchema(),
+	"wait_until_ready": WaitUntilReadySchema("materialized view"),
 }
 
 func MaterializedView() *schema.Resource {
@@ -57,10 +68,60 @@ func MaterializedView() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: materializedViewReplacementDiff,
+
 		Schema: materializedViewSchema,
 	}
 }
 
+// The definition is only changed in place when it is swapped for a shadow
+func materializedViewReplacementDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.Get("replacement_strategy").(string) != "create_then_swap" {
+		return materializedViewForceNew(d)
+	}
+
+	if !statementChanged(d) && !d.HasChange("not_null_assertion") {
+		return nil
+	}
+
+	// Only sinks are moved to the shadow, so other dependents would be dropped
+	// along with the materialized view
+	oldName, _ := d.GetChange("name")
+	qn := materialize.QualifiedName(d.Get("database_name").(string), d.Get("schema_name").(string), oldName.(string))
+	_, others, err := materializedViewDependents(meta.(*sqlx.DB), utils.ExtractId(d.Id()))
+	if err != nil {
+		return err
+	}
+
+	if len(others) > 0 {
+		log.Printf("[WARN] objects other than sinks depend on materialized view %s and are not recreated on the shadow, replacing the materialized view: %s", qn, strings.Join(others, ", "))
+		return materializedViewForceNew(d)
+	}
+	return nil
+}
+
+func materializedViewForceNew(d *schema.ResourceDiff) error {
+	if statementChanged(d) {
+		if err := d.ForceNew("statement"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("not_null_assertion") {
+		return d.ForceNew("not_null_assertion")
+	}
+	return nil
+}
+
 func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	i := d.Id()
 
@@ -103,6 +164,10 @@ func materializedViewRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	if err := setStatement(d, s.Definition.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -161,6 +226,12 @@ func materializedViewCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if d.Get("wait_until_ready").(bool) {
+		if err := materializedViewWait(ctx, meta.(*sqlx.DB), o, i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return materializedViewRead(ctx, d, meta)
 }
 
@@ -180,20 +251,46 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("ownership_role") {
-		_, newRole := d.GetChange("ownership_role")
+	// The swapped in materialized view is a new object, so its privileges are
+	// granted on the shadow and ownership and comment are reapplied.
+	// Other strategies replace the materialized view on these changes.
+	swapped := false
+	if d.Get("replacement_strategy").(string) == "create_then_swap" && d.HasChanges("statement", "not_null_assertion") {
+		b, err := materializedViewSwap(ctx, meta.(*sqlx.DB), d, o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		i, err := materialize.MaterializedViewId(meta.(*sqlx.DB), o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(utils.TransformIdWithRegion(i))
+
+		if err := b.DropBackup(); err != nil {
+			return diag.FromErr(err)
+		}
+		swapped = true
+	}
+
+	if v, ok := d.GetOk("ownership_role"); d.HasChange("ownership_role") || (swapped && ok) {
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
 
-		if err := b.Alter(newRole.(string)); err != nil {
+		if err := b.Alter(v.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("comment") {
-		_, newComment := d.GetChange("comment")
+	if v, ok := d.GetOk("comment"); d.HasChange("comment") || (swapped && ok) {
 		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
 
-		if err := b.Object(newComment.(string)); err != nil {
+		if err := b.Object(v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("wait_until_ready") && d.Get("wait_until_ready").(bool) {
+		if err := materializedViewWait(ctx, meta.(*sqlx.DB), o, utils.ExtractId(d.Id()), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -201,6 +298,112 @@ func materializedViewUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	return materializedViewRead(ctx, d, meta)
 }
 
+// Build the new definition under a shadow name and swap it into place once
+// hydrated, moving any sinks over so they keep running through the change
+func materializedViewSwap(ctx context.Context, conn *sqlx.DB, d *schema.ResourceData, o materialize.MaterializeObject) (*materialize.MaterializedViewBuilder, error) {
+	sinks, others, err := materializedViewDependents(conn, utils.ExtractId(d.Id()))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(others) > 0 {
+		return nil, fmt.Errorf("materialized view %s cannot be swapped while objects other than sinks depend on it: %s", o.QualifiedName(), strings.Join(others, ", "))
+	}
+
+	b := materialize.NewMaterializedViewBuilder(conn, o)
+	b.ClusterName(d.Get("cluster_name").(string))
+	b.NotNullAssertions(materialize.GetSliceValueString(d.Get("not_null_assertion").([]interface{})))
+	b.SelectStmt(d.Get("statement").(string))
+
+	if err := b.CreateShadow(); err != nil {
+		return nil, err
+	}
+
+	shadow := b.ShadowObject()
+	privileges, err := materialize.ScanPrivileges(conn, "MATERIALIZED VIEW", utils.ExtractId(d.Id()))
+	if err == nil {
+		err = materialize.GrantPrivileges(conn, shadow, privileges)
+	}
+	if err != nil {
+		b.DropShadow()
+		return nil, err
+	}
+
+	i, err := materialize.MaterializedViewId(conn, shadow)
+	if err != nil {
+		b.DropShadow()
+		return nil, err
+	}
+
+	if err := materializedViewWait(ctx, conn, shadow, i, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		log.Printf("[DEBUG] shadow not hydrated, dropping object: %s", shadow.Name)
+		b.DropShadow()
+		return nil, err
+	}
+
+	for n, sink := range sinks {
+		if err := moveSink(conn, sink, shadow); err != nil {
+			return nil, materializedViewSwapRollback(conn, b, o, sinks[:n], err)
+		}
+	}
+
+	if err := b.Replace(); err != nil {
+		return nil, materializedViewSwapRollback(conn, b, o, sinks, err)
+	}
+
+	return b, nil
+}
+
+// Dependents of the materialized view, split into the sinks that are moved to
+// the shadow and the qualified names of other objects, such as indexes and
+// views, that are not recreated on it
+func materializedViewDependents(conn *sqlx.DB, id string) ([]materialize.MaterializeObject, []string, error) {
+	deps, err := materialize.ListDependents(conn, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sinks []materialize.MaterializeObject
+	var others []string
+	for _, dep := range deps {
+		do := materialize.MaterializeObject{Name: dep.ObjectName.String, SchemaName: dep.SchemaName.String, DatabaseName: dep.DatabaseName.String}
+		if dep.Type.String == "sink" {
+			sinks = append(sinks, do)
+		} else {
+			others = append(others, do.QualifiedName())
+		}
+	}
+	return sinks, others, nil
+}
+
+func moveSink(conn *sqlx.DB, sink, to materialize.MaterializeObject) error {
+	from := materialize.IdentifierSchemaStruct{Name: to.Name, SchemaName: to.SchemaName, DatabaseName: to.DatabaseName}
+	if err := materialize.NewSink(conn, sink).AlterFrom(from); err != nil {
+		return fmt.Errorf("unable to move sink %s to %s: %s", sink.QualifiedName(), to.QualifiedName(), err)
+	}
+	return nil
+}
+
+// Move the sinks back to the materialized view and drop the shadow once
+// nothing reads from it
+func materializedViewSwapRollback(conn *sqlx.DB, b *materialize.MaterializedViewBuilder, o materialize.MaterializeObject, sinks []materialize.MaterializeObject, err error) error {
+	for _, sink := range sinks {
+		if serr := moveSink(conn, sink, o); serr != nil {
+			return fmt.Errorf("%s, %s", err, serr)
+		}
+	}
+
+	b.DropShadow()
+	return err
+}
+
+func materializedViewWait(ctx context.Context, conn *sqlx.DB, o materialize.MaterializeObject, id string, timeout time.Duration) error {
+	name := fmt.Sprintf("materialized view %s", o.QualifiedName())
+	return waitForHydration(ctx, timeout, name, true, func() ([]materialize.HydrationStatusParams, error) {
+		return materialize.ListObjectHydrationStatuses(conn, id)
+	})
+}
+
 func materializedViewDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	materializedViewName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
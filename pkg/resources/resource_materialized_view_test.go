@@ -2,12 +2,14 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +48,44 @@ func TestResourceMaterializedViewCreate(t *testing.T) {
 	})
 }
 
+func TestResourceMaterializedViewCreateWaitUntilReady(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "materialized_view",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"cluster_name":     "cluster",
+		"statement":        "SELECT 1 FROM 1",
+		"wait_until_ready": true,
+	}
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view" IN CLUSTER "cluster" AS SELECT 1 FROM 1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view' AND mz_schemas.name = 'schema'`
+		testhelpers.MockMaterializeViewScan(mock, ip)
+
+		// Hydration, lagging on the second replica before completing
+		hp := `WHERE mz_hydration_statuses.object_id = 'u1'`
+		testhelpers.MockHydrationStatusScan(mock, hp, false)
+		testhelpers.MockHydrationStatusScan(mock, hp, true)
+
+		// Query Params
+		pp := `WHERE mz_materialized_views.id = 'u1'`
+		testhelpers.MockMaterializeViewScan(mock, pp)
+
+		if err := materializedViewCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 // Confirm id is updated with region for 0.4.0
 func TestResourceMaterializedViewReadIdMigration(t *testing.T) {
 	r := require.New(t)
@@ -92,6 +132,214 @@ func TestResourceMaterializedViewUpdate(t *testing.T) {
 	})
 }
 
+var materializedViewState = &terraform.InstanceState{ID: "aws/us-east-1:u1", Attributes: map[string]string{
+	"id":                   "aws/us-east-1:u1",
+	"name":                 "materialized_view",
+	"schema_name":          "schema",
+	"database_name":        "database",
+	"qualified_sql_name":   `"database"."schema"."materialized_view"`,
+	"cluster_name":         "cluster",
+	"not_null_assertion.#": "1",
+	"not_null_assertion.0": "column_1",
+	"statement":            "SELECT 1 FROM 1",
+	"ownership_role":       "joe",
+	"replacement_strategy": "create_then_swap",
+	"wait_until_ready":     "false",
+}}
+
+func TestResourceMaterializedViewUpdateName(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                 "new_materialized_view",
+		"schema_name":          "schema",
+		"database_name":        "database",
+		"cluster_name":         "cluster",
+		"not_null_assertion":   []interface{}{"column_1"},
+		"statement":            "SELECT 1 FROM 1",
+		"replacement_strategy": "create_then_swap",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := MaterializedView().Diff(context.TODO(), materializedViewState, config, db)
+		r.NoError(err)
+		d, err := schema.InternalMap(MaterializedView().Schema).Data(materializedViewState, diff)
+		r.NoError(err)
+
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" RENAME TO "new_materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_materialized_views.id = 'u1'`
+		testhelpers.MockMaterializeViewScan(mock, pp)
+
+		if err := materializedViewUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceMaterializedViewUpdateStatementReplace(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "materialized_view",
+		"schema_name":        "schema",
+		"database_name":      "database",
+		"cluster_name":       "cluster",
+		"not_null_assertion": []interface{}{"column_1"},
+		"statement":          "SELECT 2 FROM 1",
+	})
+
+	// The default strategy replaces the materialized view
+	diff, err := MaterializedView().Diff(context.TODO(), materializedViewState, config, nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+}
+
+func TestResourceMaterializedViewUpdateCreateThenSwap(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                 "materialized_view",
+		"schema_name":          "schema",
+		"database_name":        "database",
+		"cluster_name":         "cluster",
+		"not_null_assertion":   []interface{}{"column_1"},
+		"statement":            "SELECT 2 FROM 1",
+		"replacement_strategy": "create_then_swap",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"sink", "sink"}})
+
+		diff, err := MaterializedView().Diff(context.TODO(), materializedViewState, config, db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+
+		d, err := schema.InternalMap(MaterializedView().Schema).Data(materializedViewState, diff)
+		r.NoError(err)
+
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"sink", "sink"}})
+
+		// Build and hydrate the shadow
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" IN CLUSTER "cluster" WITH \(ASSERT NOT NULL "column_1"\) AS SELECT 2 FROM 1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// The privileges of the materialized view are granted on the shadow
+		testhelpers.MockMaterializeViewScan(mock, `WHERE mz_materialized_views.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		mock.ExpectExec(`GRANT USAGE, CREATE ON TABLE "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u8'`)
+		mock.ExpectExec(`GRANT INSERT, SELECT, UPDATE ON TABLE "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		sp := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view_shadow_[0-9a-f]{8}' AND mz_schemas.name = 'schema'`
+		testhelpers.MockMaterializeViewIdScan(mock, sp, "u2")
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_hydration_statuses.object_id = 'u2'`, true)
+
+		// Move the sinks and swap
+		mock.ExpectExec(`ALTER SINK "database"."schema"."sink" SET FROM "database"."schema"."materialized_view_shadow_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" RENAME TO "materialized_view_old_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" RENAME TO "materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view' AND mz_schemas.name = 'schema'`
+		testhelpers.MockMaterializeViewIdScan(mock, ip, "u2")
+
+		// The previous materialized view is only dropped once the shadow is in place
+		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view_old_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_materialized_views.id = 'u2'`
+		testhelpers.MockMaterializeViewIdScan(mock, pp, "u2")
+
+		if err := materializedViewUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("aws/us-east-1:u2", d.Id())
+	})
+}
+
+func TestResourceMaterializedViewUpdateCreateThenSwapDependents(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                 "materialized_view",
+		"schema_name":          "schema",
+		"database_name":        "database",
+		"cluster_name":         "cluster",
+		"not_null_assertion":   []interface{}{"column_1"},
+		"statement":            "SELECT 2 FROM 1",
+		"replacement_strategy": "create_then_swap",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Indexes are not recreated on the shadow, so the materialized view is replaced
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"sink", "sink"}, {"index", "index"}})
+
+		diff, err := MaterializedView().Diff(context.TODO(), materializedViewState, config, db)
+		r.NoError(err)
+		r.True(diff.RequiresNew())
+	})
+}
+
+func TestResourceMaterializedViewUpdateCreateThenSwapRollback(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                 "materialized_view",
+		"schema_name":          "schema",
+		"database_name":        "database",
+		"cluster_name":         "cluster",
+		"not_null_assertion":   []interface{}{"column_1"},
+		"statement":            "SELECT 2 FROM 1",
+		"replacement_strategy": "create_then_swap",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"sink", "sink"}})
+
+		diff, err := MaterializedView().Diff(context.TODO(), materializedViewState, config, db)
+		r.NoError(err)
+		d, err := schema.InternalMap(MaterializedView().Schema).Data(materializedViewState, diff)
+		r.NoError(err)
+
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"sink", "sink"}})
+		mock.ExpectExec(
+			`CREATE MATERIALIZED VIEW "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" IN CLUSTER "cluster" WITH \(ASSERT NOT NULL "column_1"\) AS SELECT 2 FROM 1;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// The privileges of the materialized view are granted on the shadow
+		testhelpers.MockMaterializeViewScan(mock, `WHERE mz_materialized_views.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		mock.ExpectExec(`GRANT USAGE, CREATE ON TABLE "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u8'`)
+		mock.ExpectExec(`GRANT INSERT, SELECT, UPDATE ON TABLE "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		sp := `WHERE mz_databases.name = 'database' AND mz_materialized_views.name = 'materialized_view_shadow_[0-9a-f]{8}' AND mz_schemas.name = 'schema'`
+		testhelpers.MockMaterializeViewIdScan(mock, sp, "u2")
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_hydration_statuses.object_id = 'u2'`, true)
+		mock.ExpectExec(`ALTER SINK "database"."schema"."sink" SET FROM "database"."schema"."materialized_view_shadow_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// The renames are rolled back when the shadow cannot take its place
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view" RENAME TO "materialized_view_old_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER MATERIALIZED VIEW "database"."schema"."materialized_view_shadow_[0-9a-f]{8}" RENAME TO "materialized_view";`).WillReturnError(fmt.Errorf("rename failed"))
+		mock.ExpectRollback()
+
+		// The sinks move back before the shadow is dropped
+		mock.ExpectExec(`ALTER SINK "database"."schema"."sink" SET FROM "database"."schema"."materialized_view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DROP MATERIALIZED VIEW "database"."schema"."materialized_view_shadow_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := materializedViewUpdate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Equal("rename failed", diags[0].Summary)
+		r.Equal("aws/us-east-1:u1", d.Id())
+	})
+}
+
 func TestResourceMaterializedViewDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -2,7 +2,9 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"fmt"
 	"log"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -22,6 +24,35 @@ var roleSchema = map[string]*schema.Schema{
 		Type:        schema.TypeBool,
 		Computed:    true,
 	},
+	"login": {
+		Description: "Allows the role to log in. Only applicable to self-managed deployments using password authentication.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"superuser": {
+		Description: "Grants the role superuser privileges. Only applicable to self-managed deployments.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"password": {
+		Description: "The password the role uses to log in. Only applicable to self-managed deployments using password authentication. Only a SHA-256 digest of the password is kept in the Terraform state, to detect changes. The password cannot be read back from Materialize.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		StateFunc:   passwordDigest,
+	},
+}
+
+// Keep the plaintext password out of state, the digest is enough to detect
+// changes to the configured password
+func passwordDigest(v interface{}) string {
+	p := v.(string)
+	if p == "" {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(p)))
 }
 
 func Role() *schema.Resource {
@@ -61,6 +92,14 @@ func roleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("login", s.Login.Bool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("superuser", s.Superuser.Bool); err != nil {
+		return diag.FromErr(err)
+	}
+
 	qn := materialize.QualifiedName(s.RoleName.String)
 	if err := d.Set("qualified_sql_name", qn); err != nil {
 		return diag.FromErr(err)
@@ -83,6 +122,18 @@ func roleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		b.Inherit()
 	}
 
+	if v, ok := d.GetOk("login"); ok && v.(bool) {
+		b.Login()
+	}
+
+	if v, ok := d.GetOk("superuser"); ok && v.(bool) {
+		b.Superuser()
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		b.Password(v.(string))
+	}
+
 	// create resource
 	if err := b.Create(); err != nil {
 		return diag.FromErr(err)
@@ -113,6 +164,34 @@ func roleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	roleName := d.Get("name").(string)
 
 	o := materialize.MaterializeObject{ObjectType: "ROLE", Name: roleName}
+	b := materialize.NewRoleBuilder(meta.(*sqlx.DB), o)
+
+	if d.HasChange("login") {
+		p := "NOLOGIN"
+		if d.Get("login").(bool) {
+			p = "LOGIN"
+		}
+		if err := b.Alter(p); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("superuser") {
+		p := "NOSUPERUSER"
+		if d.Get("superuser").(bool) {
+			p = "SUPERUSER"
+		}
+		if err := b.Alter(p); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("password") {
+		_, newPassword := d.GetChange("password")
+		if err := b.AlterPassword(newPassword.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
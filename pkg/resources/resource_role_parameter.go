@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var roleParameterSchema = map[string]*schema.Schema{
+	"role_name": {
+		Description: "The name of the role to set the session variable default for.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"variable_name": {
+		Description: "The name of the session variable, such as `cluster`, `search_path` or `statement_timeout`.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"variable_value": {
+		Description: "The default value of the session variable for the role. Separate the elements of list values, such as `search_path`, with commas.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+}
+
+func RoleParameter() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sets the default value of a session variable for a role.",
+
+		CreateContext: roleParameterCreate,
+		ReadContext:   roleParameterRead,
+		UpdateContext: roleParameterUpdate,
+		DeleteContext: roleParameterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: roleParameterSchema,
+	}
+}
+
+type RoleParameterKey struct {
+	roleId       string
+	variableName string
+}
+
+func parseRoleParameterKey(id string) (RoleParameterKey, error) {
+	ie := strings.Split(id, "|")
+
+	if len(ie) != 3 {
+		return RoleParameterKey{}, fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return RoleParameterKey{roleId: ie[1], variableName: ie[2]}, nil
+}
+
+func roleParameterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	key, err := parseRoleParameterKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanRoleParameter(meta.(*sqlx.DB), key.roleId, key.variableName)
+	if err == sql.ErrNoRows {
+		log.Printf("[WARN] role parameter (%s) not found, removing from state file", i)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(i))
+
+	if err := d.Set("role_name", s.RoleName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("variable_name", s.ParameterName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("variable_value", s.ParameterValue.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func roleParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+	variableName := d.Get("variable_name").(string)
+	variableValue := d.Get("variable_value").(string)
+
+	b := materialize.NewRoleParameterBuilder(meta.(*sqlx.DB), roleName, variableName)
+
+	if err := b.Set(variableValue); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// set id
+	rId, err := materialize.RoleId(meta.(*sqlx.DB), roleName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(b.ParameterKey(utils.Region, rId))
+
+	return roleParameterRead(ctx, d, meta)
+}
+
+func roleParameterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+	variableName := d.Get("variable_name").(string)
+
+	if d.HasChange("variable_value") {
+		_, newValue := d.GetChange("variable_value")
+		b := materialize.NewRoleParameterBuilder(meta.(*sqlx.DB), roleName, variableName)
+
+		if err := b.Set(newValue.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return roleParameterRead(ctx, d, meta)
+}
+
+func roleParameterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleName := d.Get("role_name").(string)
+	variableName := d.Get("variable_name").(string)
+
+	b := materialize.NewRoleParameterBuilder(meta.(*sqlx.DB), roleName, variableName)
+
+	if err := b.Reset(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
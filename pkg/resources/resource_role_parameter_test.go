@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inRoleParameter = map[string]interface{}{
+	"role_name":      "role",
+	"variable_name":  "cluster",
+	"variable_value": "quickstart",
+}
+
+func TestResourceRoleParameterCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, RoleParameter().Schema, inRoleParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`ALTER ROLE "role" SET cluster = 'quickstart';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_roles.name = 'role'`
+		testhelpers.MockRoleScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_role_parameters.parameter_name = 'cluster' AND mz_role_parameters.role_id = 'u1'`
+		testhelpers.MockRoleParameterScan(mock, pp)
+
+		if err := roleParameterCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Id() != "aws/us-east-1:ROLE PARAMETER|u1|cluster" {
+			t.Fatalf("unexpected id of %s", d.Id())
+		}
+	})
+}
+
+func TestResourceRoleParameterImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, RoleParameter().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	d.SetId("aws/us-east-1:ROLE PARAMETER|u1|cluster")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_role_parameters.parameter_name = 'cluster' AND mz_role_parameters.role_id = 'u1'`
+		testhelpers.MockRoleParameterScan(mock, pp)
+
+		if err := roleParameterRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("role", d.Get("role_name"))
+		r.Equal("cluster", d.Get("variable_name"))
+		r.Equal("quickstart", d.Get("variable_value"))
+	})
+}
+
+func TestResourceRoleParameterUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, RoleParameter().Schema, inRoleParameter)
+	r.NotNil(d)
+
+	// Set current state
+	d.SetId("aws/us-east-1:ROLE PARAMETER|u1|cluster")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER ROLE "role" SET cluster = 'quickstart';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_role_parameters.parameter_name = 'cluster' AND mz_role_parameters.role_id = 'u1'`
+		testhelpers.MockRoleParameterScan(mock, pp)
+
+		if err := roleParameterUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceRoleParameterDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, RoleParameter().Schema, inRoleParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER ROLE "role" RESET cluster;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := roleParameterDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +43,47 @@ func TestResourceRoleCreate(t *testing.T) {
 	})
 }
 
+func TestResourceRoleCreateLogin(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":      "role",
+		"inherit":   true,
+		"login":     true,
+		"superuser": true,
+		"password":  "password",
+	}
+	d := schema.TestResourceDataRaw(t, Role().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE ROLE "role" INHERIT LOGIN SUPERUSER PASSWORD 'password';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_roles.name = 'role'`
+		testhelpers.MockRoleScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_roles.id = 'u1'`
+		testhelpers.MockRoleScan(mock, pp)
+
+		if err := roleCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Get("login") != true {
+			t.Fatalf("unexpected login of %v", d.Get("login"))
+		}
+
+		// Only the digest of the password is stored
+		if p := d.State().Attributes["password"]; p != passwordDigest("password") {
+			t.Fatalf("unexpected password in state of %s", p)
+		}
+	})
+}
+
 // Confirm id is updated with region for 0.4.0
 func TestResourceRoleReadIdMigration(t *testing.T) {
 	r := require.New(t)
@@ -66,6 +108,56 @@ func TestResourceRoleReadIdMigration(t *testing.T) {
 	})
 }
 
+func TestResourceRoleUpdate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":      "role",
+		"login":     true,
+		"superuser": true,
+		"password":  "password",
+	}
+	d := schema.TestResourceDataRaw(t, Role().Schema, in)
+
+	// Set current state
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER ROLE "role" LOGIN;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER ROLE "role" SUPERUSER;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER ROLE "role" PASSWORD 'password';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_roles.id = 'u1'`
+		testhelpers.MockRoleScan(mock, pp)
+
+		if err := roleUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceRolePasswordUnchanged(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "aws/us-east-1:u1", Attributes: map[string]string{
+		"id":        "aws/us-east-1:u1",
+		"name":      "role",
+		"login":     "true",
+		"superuser": "false",
+		"password":  passwordDigest("password"),
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "role",
+		"login":    true,
+		"password": "password",
+	})
+
+	diff, err := Role().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.Nil(diff)
+}
+
 func TestResourceRoleDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -0,0 +1,173 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var schemaSwapSchema = map[string]*schema.Schema{
+	"schema_name": {
+		Description: "The schema serving traffic, such as the blue schema of a blue/green deployment.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"swap_with": {
+		Description: "The schema to swap with `schema_name`, such as the green schema the new version of the objects is deployed to.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"database_name": DatabaseNameSchema("schemas", false),
+	"trigger": {
+		Description: "An arbitrary value, such as a release version. The schemas are swapped whenever it changes, but not when the resource is created.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"wait_until_ready": {
+		Description: "Wait for every object in `swap_with` to be hydrated on every replica before swapping, for up to the `update` timeout. Replicas that are still hydrating are reported if the timeout expires, and the schemas are not swapped.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+}
+
+func SchemaSwap() *schema.Resource {
+	return &schema.Resource{
+		Description: "Swaps two schemas with `ALTER SCHEMA ... SWAP WITH` whenever `trigger` changes, to cut over a blue/green deployment in a single change. Resources managing the swapped schemas read them by id and report the other name after a swap, so ignore changes to their names with `lifecycle { ignore_changes = [name] }`.",
+
+		CreateContext: schemaSwapCreate,
+		ReadContext:   schemaSwapRead,
+		UpdateContext: schemaSwapUpdate,
+		DeleteContext: schemaSwapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: schemaSwapSchema,
+	}
+}
+
+type SchemaSwapKey struct {
+	databaseName string
+	schemaName   string
+	swapWith     string
+}
+
+// The schemas are identified by name, as swapping exchanges their ids
+func schemaSwapKey(region, databaseName, schemaName, swapWith string) string {
+	return fmt.Sprintf(`%[1]s:SCHEMA SWAP|%[2]s|%[3]s|%[4]s`, region, databaseName, schemaName, swapWith)
+}
+
+func parseSchemaSwapKey(id string) (SchemaSwapKey, error) {
+	ie := strings.Split(id, "|")
+
+	if len(ie) != 4 {
+		return SchemaSwapKey{}, fmt.Errorf("%s cannot be parsed correctly", id)
+	}
+
+	return SchemaSwapKey{databaseName: ie[1], schemaName: ie[2], swapWith: ie[3]}, nil
+}
+
+func schemaSwapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	key, err := parseSchemaSwapKey(i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, s := range []string{key.schemaName, key.swapWith} {
+		o := materialize.MaterializeObject{Name: s, DatabaseName: key.databaseName}
+		if _, err := materialize.SchemaId(meta.(*sqlx.DB), o); err == sql.ErrNoRows {
+			log.Printf("[WARN] schema (%s) not found, removing schema swap from state file", s)
+			d.SetId("")
+			return nil
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(utils.TransformIdWithRegion(i))
+
+	if err := d.Set("database_name", key.databaseName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", key.schemaName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("swap_with", key.swapWith); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func schemaSwapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaName := d.Get("schema_name").(string)
+	swapWith := d.Get("swap_with").(string)
+	databaseName := d.Get("database_name").(string)
+
+	d.SetId(schemaSwapKey(utils.Region, databaseName, schemaName, swapWith))
+
+	diags := schemaSwapRead(ctx, d, meta)
+	if !diags.HasError() && d.Id() == "" {
+		return diag.Errorf("schemas %s and %s must exist in database %s", schemaName, swapWith, databaseName)
+	}
+	return diags
+}
+
+func schemaSwapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaName := d.Get("schema_name").(string)
+	swapWith := d.Get("swap_with").(string)
+	databaseName := d.Get("database_name").(string)
+
+	if d.HasChange("trigger") {
+		if d.Get("wait_until_ready").(bool) {
+			o := materialize.MaterializeObject{Name: swapWith, DatabaseName: databaseName}
+			i, err := materialize.SchemaId(meta.(*sqlx.DB), o)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			name := fmt.Sprintf("schema %s", materialize.QualifiedName(databaseName, swapWith))
+			err = waitForHydration(ctx, d.Timeout(schema.TimeoutUpdate), name, false, func() ([]materialize.HydrationStatusParams, error) {
+				return materialize.ListSchemaHydrationStatuses(meta.(*sqlx.DB), i)
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		o := materialize.MaterializeObject{ObjectType: "SCHEMA", Name: schemaName, DatabaseName: databaseName}
+		b := materialize.NewSchemaBuilder(meta.(*sqlx.DB), o)
+		if err := b.Swap(swapWith); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return schemaSwapRead(ctx, d, meta)
+}
+
+// Removing the resource leaves the schemas as they are
+func schemaSwapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
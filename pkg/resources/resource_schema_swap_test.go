@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inSchemaSwap = map[string]interface{}{
+	"schema_name":   "blue",
+	"swap_with":     "green",
+	"database_name": "database",
+	"trigger":       "v1",
+}
+
+func TestResourceSchemaSwapCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SchemaSwap().Schema, inSchemaSwap)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Creating does not swap, only the schemas are read
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'blue'`)
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'green'`)
+
+		if err := schemaSwapCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:SCHEMA SWAP|database|blue|green", d.Id())
+	})
+}
+
+func TestResourceSchemaSwapUpdateTrigger(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "aws/us-east-1:SCHEMA SWAP|database|blue|green", Attributes: map[string]string{
+		"id":               "aws/us-east-1:SCHEMA SWAP|database|blue|green",
+		"schema_name":      "blue",
+		"swap_with":        "green",
+		"database_name":    "database",
+		"trigger":          "v1",
+		"wait_until_ready": "true",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"schema_name":      "blue",
+		"swap_with":        "green",
+		"database_name":    "database",
+		"trigger":          "v2",
+		"wait_until_ready": true,
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		diff, err := SchemaSwap().Diff(context.TODO(), state, config, nil)
+		r.NoError(err)
+		d, err := schema.InternalMap(SchemaSwap().Schema).Data(state, diff)
+		r.NoError(err)
+
+		// Wait for the objects in the green schema to hydrate
+		gp := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'green'`
+		testhelpers.MockSchemaScan(mock, gp)
+		testhelpers.MockHydrationStatusScan(mock, `WHERE mz_objects.schema_id = 'u1'`, true)
+
+		mock.ExpectExec(`ALTER SCHEMA "database"."blue" SWAP WITH "green";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'blue'`)
+		testhelpers.MockSchemaScan(mock, gp)
+
+		if err := schemaSwapUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSchemaSwapImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SchemaSwap().Schema, map[string]interface{}{})
+	r.NotNil(d)
+
+	d.SetId("aws/us-east-1:SCHEMA SWAP|database|blue|green")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'blue'`)
+		testhelpers.MockSchemaScan(mock, `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'green'`)
+
+		if err := schemaSwapRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("database", d.Get("database_name"))
+		r.Equal("blue", d.Get("schema_name"))
+		r.Equal("green", d.Get("swap_with"))
+	})
+}
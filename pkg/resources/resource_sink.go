@@ -3,11 +3,14 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 )
@@ -49,6 +52,10 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("topic", s.Topic.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	b := materialize.Sink{SinkName: s.SinkName.String, SchemaName: s.SchemaName.String, DatabaseName: s.DatabaseName.String}
 	if err := d.Set("qualified_sql_name", b.QualifiedName()); err != nil {
 		return diag.FromErr(err)
@@ -58,6 +65,14 @@ func sinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("status", s.Status.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("error", s.Error.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -85,6 +100,14 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
+	if d.HasChange("from") {
+		_, newFrom := d.GetChange("from")
+		from := materialize.GetIdentifierSchemaStruct(newFrom)
+		if err := b.AlterFrom(from); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
@@ -103,9 +126,38 @@ func sinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
+	if d.HasChange("wait_for_running") && d.Get("wait_for_running").(bool) {
+		if err := sinkWait(ctx, meta.(*sqlx.DB), utils.ExtractId(d.Id()), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sinkRead(ctx, d, meta)
 }
 
+// sinkWait waits for the sink to be running, failing with the error reported
+// by the sink if it stalls, for example on topic creation or schema registry
+// compatibility.
+func sinkWait(ctx context.Context, conn *sqlx.DB, id string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		s, err := materialize.ScanSink(conn, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		qn := materialize.QualifiedName(s.DatabaseName.String, s.SchemaName.String, s.SinkName.String)
+		if err := statusFailure("sink", qn, s.Status.String, s.Error.String); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if s.Status.String != "running" {
+			return retry.RetryableError(fmt.Errorf("sink %s is not running yet", qn))
+		}
+
+		return nil
+	})
+}
+
 func sinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sinkName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
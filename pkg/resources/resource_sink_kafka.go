@@ -2,13 +2,17 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -20,7 +24,7 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 	"comment":            CommentSchema(false),
 	"cluster_name":       ObjectClusterNameSchema("sink"),
 	"size":               ObjectSizeSchema("sink"),
-	"from":               IdentifierSchema("from", "The name of the source, table or materialized view you want to send to the sink.", true),
+	"from":               sinkFromSchema(),
 	"kafka_connection":   IdentifierSchema("kafka_connection", "The name of the Kafka connection to use in the sink.", true),
 	"topic": {
 		Description: "The Kafka topic you want to subscribe to.",
@@ -28,6 +32,50 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
+	"compression_type": {
+		Description:  "The compression algorithm used to compress the Kafka messages.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(compressionTypes, true),
+	},
+	"partition_by": {
+		Description: "A SQL expression returning a hash used to determine the partition a message is written to.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"topic_replication_factor": {
+		Description: "The replication factor to use when creating the Kafka topic, if the topic does not already exist.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"topic_partition_count": {
+		Description: "The partition count to use when creating the Kafka topic, if the topic does not already exist.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"topic_config": {
+		Description: "Any topic-level configs to use when creating the Kafka topic, if the topic does not already exist.",
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"progress_group_id_prefix": {
+		Description: "The prefix of the consumer group ID used to read the progress topic.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"transactional_id_prefix": {
+		Description: "The prefix of the transactional ID used when producing to the Kafka topic.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
 	"key": {
 		Description: "An optional list of columns to use for the Kafka key. If unspecified, the Kafka key is left unset.",
 		Type:        schema.TypeList,
@@ -35,7 +83,15 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		Optional:    true,
 		ForceNew:    true,
 	},
-	"format": SinkFormatSpecSchema("format", "How to decode raw bytes from different formats into data structures it can understand at runtime.", false),
+	"headers": {
+		Description: "The name of a column of type `map[text => text]` or `map[text => bytea]` to emit as the Kafka message headers.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"format":       sinkFormatSchema("format", "How to decode raw bytes from different formats into data structures it can understand at runtime.", []string{"key_format", "value_format"}),
+	"key_format":   sinkFormatSchema("key_format", "Set the key format explicitly.", []string{"format"}),
+	"value_format": sinkFormatSchema("value_format", "Set the value format explicitly.", []string{"format"}),
 	"envelope": {
 		Description: "How to interpret records (e.g. Debezium, Upsert).",
 		Type:        schema.TypeList,
@@ -76,6 +132,22 @@ var sinkKafkaSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Default:     false,
 	},
+	"status":           StatusSchema("sink"),
+	"error":            StatusErrorSchema("sink"),
+	"wait_for_running": WaitForRunningSchema("sink"),
+}
+
+func sinkFormatSchema(elem, description string, conflictsWith []string) *schema.Schema {
+	s := SinkFormatSpecSchema(elem, description, false)
+	s.ConflictsWith = conflictsWith
+	return s
+}
+
+// The upstream relation can be changed in place with ALTER SINK ... SET FROM
+func sinkFromSchema() *schema.Schema {
+	s := IdentifierSchema("from", "The name of the source, table or materialized view you want to send to the sink. Changing the relation alters the sink in place, provided the new relation is compatible with the existing key and value columns.", true)
+	s.ForceNew = false
+	return s
 }
 
 func SinkKafka() *schema.Resource {
@@ -91,10 +163,84 @@ func SinkKafka() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(validSize, sinkKafkaFromDiff),
+
 		Schema: sinkKafkaSchema,
 	}
 }
 
+// Ensure the new upstream relation produces the same key and value columns
+// so changing the relation does not disturb downstream consumers
+func sinkKafkaFromDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.HasChange("from") {
+		return nil
+	}
+
+	oldFrom, newFrom := d.GetChange("from")
+	if len(oldFrom.([]interface{})) == 0 || len(newFrom.([]interface{})) == 0 {
+		return nil
+	}
+
+	conn, ok := meta.(*sqlx.DB)
+	if !ok || conn == nil {
+		return nil
+	}
+
+	o := materialize.GetIdentifierSchemaStruct(oldFrom)
+	n := materialize.GetIdentifierSchemaStruct(newFrom)
+
+	oldColumns, err := materialize.ListRelationColumns(conn, materialize.MaterializeObject{Name: o.Name, SchemaName: o.SchemaName, DatabaseName: o.DatabaseName})
+	if err != nil {
+		return err
+	}
+
+	newColumns, err := materialize.ListRelationColumns(conn, materialize.MaterializeObject{Name: n.Name, SchemaName: n.SchemaName, DatabaseName: n.DatabaseName})
+	if err != nil {
+		return err
+	}
+
+	// Relations created within the same apply cannot be checked at plan time
+	if len(oldColumns) == 0 || len(newColumns) == 0 {
+		log.Printf("[DEBUG] unable to compare columns of %s and %s, skipping compatibility check", o.QualifiedName(), n.QualifiedName())
+		return nil
+	}
+
+	newTypes := map[string]string{}
+	for _, c := range newColumns {
+		newTypes[c.Name.String] = c.Type.String
+	}
+
+	for _, k := range d.Get("key").([]interface{}) {
+		key := k.(string)
+		t, ok := newTypes[key]
+		if !ok {
+			return fmt.Errorf("sink key column %q does not exist in %s", key, n.QualifiedName())
+		}
+		for _, c := range oldColumns {
+			if c.Name.String == key && c.Type.String != t {
+				return fmt.Errorf("sink key column %q changes type from %s to %s in %s", key, c.Type.String, t, n.QualifiedName())
+			}
+		}
+	}
+
+	if len(oldColumns) != len(newColumns) {
+		return fmt.Errorf("%s has %d columns but the sink value has %d columns", n.QualifiedName(), len(newColumns), len(oldColumns))
+	}
+
+	for i, c := range oldColumns {
+		nc := newColumns[i]
+		if c.Name.String != nc.Name.String || c.Type.String != nc.Type.String {
+			return fmt.Errorf("sink value column %d is %q %s but %s has %q %s", i+1, c.Name.String, c.Type.String, n.QualifiedName(), nc.Name.String, nc.Type.String)
+		}
+	}
+
+	return nil
+}
+
 func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	sinkName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -125,11 +271,47 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 		b.Topic(v.(string))
 	}
 
+	if v, ok := d.GetOk("compression_type"); ok {
+		b.CompressionType(v.(string))
+	}
+
+	if v, ok := d.GetOk("partition_by"); ok {
+		b.PartitionBy(v.(string))
+	}
+
+	if v, ok := d.GetOk("topic_replication_factor"); ok {
+		b.TopicReplicationFactor(v.(int))
+	}
+
+	if v, ok := d.GetOk("topic_partition_count"); ok {
+		b.TopicPartitionCount(v.(int))
+	}
+
+	if v, ok := d.GetOk("topic_config"); ok {
+		c := map[string]string{}
+		for k, val := range v.(map[string]interface{}) {
+			c[k] = val.(string)
+		}
+		b.TopicConfig(c)
+	}
+
+	if v, ok := d.GetOk("progress_group_id_prefix"); ok {
+		b.ProgressGroupIdPrefix(v.(string))
+	}
+
+	if v, ok := d.GetOk("transactional_id_prefix"); ok {
+		b.TransactionalIdPrefix(v.(string))
+	}
+
 	if v, ok := d.GetOk("key"); ok {
 		keys := materialize.GetSliceValueString(v.([]interface{}))
 		b.Key(keys)
 	}
 
+	if v, ok := d.GetOk("headers"); ok {
+		b.Headers(v.(string))
+	}
+
 	if v, ok := d.GetOk("key_not_enforced"); ok {
 		b.KeyNotEnforced(v.(bool))
 	}
@@ -139,6 +321,16 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 		b.Format(format)
 	}
 
+	if v, ok := d.GetOk("key_format"); ok {
+		format := materialize.GetSinkFormatSpecStruc(v)
+		b.KeyFormat(format)
+	}
+
+	if v, ok := d.GetOk("value_format"); ok {
+		format := materialize.GetSinkFormatSpecStruc(v)
+		b.ValueFormat(format)
+	}
+
 	if v, ok := d.GetOk("envelope"); ok {
 		envelope := materialize.GetSinkKafkaEnelopeStruct(v)
 		b.Envelope(envelope)
@@ -182,5 +374,11 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if d.Get("wait_for_running").(bool) {
+		if err := sinkWait(ctx, meta.(*sqlx.DB), i, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sinkRead(ctx, d, meta)
 }
@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -121,3 +122,216 @@ func TestResourceSinkKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+var inSinkKafkaTopicOptions = map[string]interface{}{
+	"name":                     "sink",
+	"schema_name":              "schema",
+	"database_name":            "database",
+	"cluster_name":             "cluster",
+	"from":                     []interface{}{map[string]interface{}{"name": "item"}},
+	"kafka_connection":         []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+	"topic":                    "topic",
+	"compression_type":         "lz4",
+	"partition_by":             "seahash(id::text)",
+	"topic_replication_factor": 3,
+	"topic_partition_count":    6,
+	"topic_config":             map[string]interface{}{"cleanup.policy": "compact"},
+	"progress_group_id_prefix": "progress",
+	"transactional_id_prefix":  "transaction",
+	"key":                      []interface{}{"id"},
+	"headers":                  "headers",
+	"key_format":               []interface{}{map[string]interface{}{"text": true}},
+	"value_format":             []interface{}{map[string]interface{}{"json": true}},
+	"envelope":                 []interface{}{map[string]interface{}{"upsert": true}},
+	"snapshot":                 false,
+}
+
+func TestResourceSinkKafkaCreateTopicOptions(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, inSinkKafkaTopicOptions)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink"
+			IN CLUSTER "cluster" FROM "materialize"."public"."item"
+			INTO KAFKA CONNECTION "materialize"."public"."kafka_conn"
+			\(TOPIC 'topic', COMPRESSION TYPE = 'lz4', PARTITION BY = seahash\(id::text\),
+			PROGRESS GROUP ID PREFIX = 'progress', TRANSACTIONAL ID PREFIX = 'transaction',
+			TOPIC CONFIG MAP\['cleanup.policy' => 'compact'\],
+			TOPIC PARTITION COUNT = 6, TOPIC REPLICATION FACTOR = 3\)
+			KEY \(id\) HEADERS "headers"
+			KEY FORMAT TEXT VALUE FORMAT JSON
+			ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sinks.name = 'sink'`
+		testhelpers.MockSinkScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sinks.id = 'u1'`
+		testhelpers.MockSinkScan(mock, pp)
+
+		if err := sinkKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("topic", d.Get("topic"))
+	})
+}
+
+var inSinkKafkaWaitForRunning = map[string]interface{}{
+	"name":             "sink",
+	"schema_name":      "schema",
+	"database_name":    "database",
+	"cluster_name":     "cluster",
+	"from":             []interface{}{map[string]interface{}{"name": "item"}},
+	"kafka_connection": []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+	"topic":            "topic",
+	"format":           []interface{}{map[string]interface{}{"json": true}},
+	"envelope":         []interface{}{map[string]interface{}{"debezium": true}},
+	"wait_for_running": true,
+}
+
+func TestResourceSinkKafkaCreateWaitForRunning(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, inSinkKafkaWaitForRunning)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink"
+			IN CLUSTER "cluster" FROM "materialize"."public"."item"
+			INTO KAFKA CONNECTION "materialize"."public"."kafka_conn"
+			\(TOPIC 'topic'\) FORMAT JSON ENVELOPE DEBEZIUM WITH \(SNAPSHOT = true\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sinks.name = 'sink'`
+		testhelpers.MockSinkScan(mock, ip)
+
+		// Wait, starting before running
+		pp := `WHERE mz_sinks.id = 'u1'`
+		testhelpers.MockSinkStatusScan(mock, pp, "starting", "")
+		testhelpers.MockSinkStatusScan(mock, pp, "running", "")
+
+		// Query Params
+		testhelpers.MockSinkScan(mock, pp)
+
+		if err := sinkKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("running", d.Get("status"))
+	})
+}
+
+func TestResourceSinkKafkaCreateWaitForRunningStalled(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SinkKafka().Schema, inSinkKafkaWaitForRunning)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SINK "database"."schema"."sink"
+			IN CLUSTER "cluster" FROM "materialize"."public"."item"
+			INTO KAFKA CONNECTION "materialize"."public"."kafka_conn"
+			\(TOPIC 'topic'\) FORMAT JSON ENVELOPE DEBEZIUM WITH \(SNAPSHOT = true\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sinks.name = 'sink'`
+		testhelpers.MockSinkScan(mock, ip)
+
+		// Wait
+		pp := `WHERE mz_sinks.id = 'u1'`
+		testhelpers.MockSinkStatusScan(mock, pp, "stalled", "topic creation failed: authorization failed")
+
+		diags := sinkKafkaCreate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Equal(`sink "database"."schema"."sink" is stalled: topic creation failed: authorization failed`, diags[0].Summary)
+	})
+}
+
+func sinkKafkaFromState() *terraform.InstanceState {
+	return &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                               "u1",
+		"name":                             "sink",
+		"schema_name":                      "schema",
+		"database_name":                    "database",
+		"topic":                            "topic",
+		"from.#":                           "1",
+		"from.0.name":                      "item",
+		"from.0.schema_name":               "public",
+		"from.0.database_name":             "database",
+		"kafka_connection.#":               "1",
+		"kafka_connection.0.name":          "kafka_conn",
+		"kafka_connection.0.schema_name":   "public",
+		"kafka_connection.0.database_name": "database",
+		"key.#":                            "1",
+		"key.0":                            "id",
+		"snapshot":                         "true",
+		"key_not_enforced":                 "false",
+	}}
+}
+
+func sinkKafkaFromConfig(from string) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "sink",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"topic":            "topic",
+		"from":             []interface{}{map[string]interface{}{"name": from, "schema_name": "public", "database_name": "database"}},
+		"kafka_connection": []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+		"key":              []interface{}{"id"},
+	})
+}
+
+func TestResourceSinkKafkaDiffFromCompatible(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item' AND mz_schemas.name = 'public'`, [][]string{{"id", "integer"}, {"value", "text"}})
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item_v2' AND mz_schemas.name = 'public'`, [][]string{{"id", "integer"}, {"value", "text"}})
+
+		diff, err := SinkKafka().Diff(context.TODO(), sinkKafkaFromState(), sinkKafkaFromConfig("item_v2"), db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+	})
+}
+
+func TestResourceSinkKafkaDiffFromMissingKey(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item' AND mz_schemas.name = 'public'`, [][]string{{"id", "integer"}, {"value", "text"}})
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item_v2' AND mz_schemas.name = 'public'`, [][]string{{"item_id", "integer"}, {"value", "text"}})
+
+		_, err := SinkKafka().Diff(context.TODO(), sinkKafkaFromState(), sinkKafkaFromConfig("item_v2"), db)
+		r.ErrorContains(err, `sink key column "id" does not exist in "database"."public"."item_v2"`)
+	})
+}
+
+func TestResourceSinkKafkaDiffFromIncompatibleValue(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item' AND mz_schemas.name = 'public'`, [][]string{{"id", "integer"}, {"value", "text"}})
+		testhelpers.MockRelationColumnScan(mock, `WHERE mz_databases.name = 'database' AND mz_objects.name = 'item_v2' AND mz_schemas.name = 'public'`, [][]string{{"id", "integer"}, {"value", "jsonb"}})
+
+		_, err := SinkKafka().Diff(context.TODO(), sinkKafkaFromState(), sinkKafkaFromConfig("item_v2"), db)
+		r.ErrorContains(err, `sink value column 2 is "value" text but "database"."public"."item_v2" has "value" jsonb`)
+	})
+}
+
+func TestResourceSinkKafkaDiffFromWithoutConnection(t *testing.T) {
+	r := require.New(t)
+
+	// The compatibility check is skipped without a connection to Materialize
+	diff, err := SinkKafka().Diff(context.TODO(), sinkKafkaFromState(), sinkKafkaFromConfig("item_v2"), nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+}
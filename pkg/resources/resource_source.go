@@ -3,11 +3,13 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 )
@@ -58,6 +60,14 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("status", s.Status.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("error", s.Error.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Subsources
 	deps, err := materialize.ListDependencies(meta.(*sqlx.DB), utils.ExtractId(i), "source")
 	if err != nil {
@@ -124,6 +134,12 @@ func sourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		}
 	}
 
+	if d.HasChanges("wait_for_running", "wait_for_snapshot") {
+		if err := sourceWait(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sourceRead(ctx, d, meta)
 }
 
@@ -140,3 +156,61 @@ func sourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	}
 	return nil
 }
+
+// Source and sink statuses that will not become running without intervention.
+var failedStatuses = []string{"paused", "stalled", "failed", "dropped"}
+
+// statusFailure returns the error reported by a source or sink that will not
+// become running, or nil if it may still start.
+func statusFailure(objectType, qn, status, detail string) error {
+	for _, f := range failedStatuses {
+		if status != f {
+			continue
+		}
+		if detail != "" {
+			return fmt.Errorf("%s %s is %s: %s", objectType, qn, status, detail)
+		}
+		return fmt.Errorf("%s %s is %s", objectType, qn, status)
+	}
+	return nil
+}
+
+// sourceWait waits for the source to be running, and to have committed its
+// initial snapshot, when requested with wait_for_running or wait_for_snapshot.
+func sourceWait(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	_, running := d.GetOk("wait_for_running")
+	_, snapshot := d.GetOk("wait_for_snapshot")
+	if !running && !snapshot {
+		return nil
+	}
+
+	conn := meta.(*sqlx.DB)
+	id := utils.ExtractId(d.Id())
+	return retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		s, err := materialize.ScanSource(conn, id)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		qn := materialize.QualifiedName(s.DatabaseName.String, s.SchemaName.String, s.SourceName.String)
+		if err := statusFailure("source", qn, s.Status.String, s.Error.String); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if s.Status.String != "running" {
+			return retry.RetryableError(fmt.Errorf("source %s is not running yet", qn))
+		}
+
+		if snapshot {
+			c, err := materialize.SourceSnapshotCommitted(conn, id)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			if !c {
+				return retry.RetryableError(fmt.Errorf("source %s has not committed its initial snapshot yet", qn))
+			}
+		}
+
+		return nil
+	})
+}
@@ -3,11 +3,13 @@ package resources
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
 )
@@ -27,6 +29,18 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
+	"group_id_prefix": {
+		Description: "The prefix of the consumer group ID to use.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"topic_metadata_refresh_interval": {
+		Description: "The interval at which to refresh the topic metadata, e.g. `30s`.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
 	"include_key": {
 		Description: "Include a column containing the Kafka message key.",
 		Type:        schema.TypeBool,
@@ -104,6 +118,53 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 					ForceNew:      true,
 					ConflictsWith: []string{"envelope.0.debezium", "envelope.0.none"},
 				},
+				"upsert_options": {
+					Description: "Options for the upsert envelope.",
+					Type:        schema.TypeList,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value_decoding_errors": {
+								Description: "How to handle errors decoding the message value.",
+								Type:        schema.TypeList,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"inline": {
+											Description: "Report decoding errors in an additional column instead of making the source unqueryable.",
+											Type:        schema.TypeList,
+											MaxItems:    1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"enabled": {
+														Description: "Include a column with the value decoding error, if any.",
+														Type:        schema.TypeBool,
+														Optional:    true,
+														ForceNew:    true,
+														Default:     false,
+													},
+													"alias": {
+														Description: "Provide an alias for the decoding error column. Defaults to `error`.",
+														Type:        schema.TypeString,
+														Optional:    true,
+														ForceNew:    true,
+													},
+												},
+											},
+											Optional: true,
+											ForceNew: true,
+										},
+									},
+								},
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"envelope.0.upsert"},
+				},
 				"debezium": {
 					Description:   "Use the Debezium envelope, which uses a diff envelope to handle CRUD operations.",
 					Type:          schema.TypeBool,
@@ -138,9 +199,13 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"start_offset"},
 	},
-	"expose_progress": IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
-	"subsource":       SubsourceSchema(),
-	"ownership_role":  OwnershipRoleSchema(),
+	"expose_progress":   IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
+	"subsource":         SubsourceSchema(),
+	"ownership_role":    OwnershipRoleSchema(),
+	"status":            StatusSchema("source"),
+	"error":             StatusErrorSchema("source"),
+	"wait_for_running":  WaitForRunningSchema("source"),
+	"wait_for_snapshot": WaitForSnapshotSchema(),
 }
 
 func SourceKafka() *schema.Resource {
@@ -156,10 +221,48 @@ func SourceKafka() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(validSize, sourceKafkaFormatDiff),
+
 		Schema: sourceKafkaSchema,
 	}
 }
 
+// Report formats and envelopes that cannot be combined at plan time, instead
+// of failing once the source is created
+func sourceKafkaFormatDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	keys := []string{"format", "key_format", "value_format", "envelope"}
+	for _, k := range keys {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	var format, keyFormat, valueFormat materialize.SourceFormatSpecStruct
+	var envelope materialize.KafkaSourceEnvelopeStruct
+
+	if v, ok := d.GetOk("format"); ok && v.([]interface{})[0] != nil {
+		format = materialize.GetFormatSpecStruc(v)
+	}
+
+	if v, ok := d.GetOk("key_format"); ok && v.([]interface{})[0] != nil {
+		keyFormat = materialize.GetFormatSpecStruc(v)
+	}
+
+	if v, ok := d.GetOk("value_format"); ok && v.([]interface{})[0] != nil {
+		valueFormat = materialize.GetFormatSpecStruc(v)
+	}
+
+	if v, ok := d.GetOk("envelope"); ok && v.([]interface{})[0] != nil {
+		envelope = materialize.GetSourceKafkaEnelopeStruct(v)
+	}
+
+	return materialize.ValidateKafkaSourceFormat(format, keyFormat, valueFormat, envelope)
+}
+
 func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -185,6 +288,14 @@ func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		b.Topic(v.(string))
 	}
 
+	if v, ok := d.GetOk("group_id_prefix"); ok {
+		b.GroupIdPrefix(v.(string))
+	}
+
+	if v, ok := d.GetOk("topic_metadata_refresh_interval"); ok {
+		b.TopicMetadataRefreshInterval(v.(string))
+	}
+
 	if v, ok := d.GetOk("include_key"); ok && v.(bool) {
 		if alias, ok := d.GetOk("include_key_alias"); ok {
 			b.IncludeKeyAlias(alias.(string))
@@ -293,5 +404,9 @@ func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if err := sourceWait(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return sourceRead(ctx, d, meta)
 }
@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -89,3 +90,90 @@ func TestResourceSourceKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+var inSourceKafkaOptions = map[string]interface{}{
+	"name":                            "source",
+	"schema_name":                     "schema",
+	"database_name":                   "database",
+	"cluster_name":                    "cluster",
+	"kafka_connection":                []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+	"topic":                           "topic",
+	"group_id_prefix":                 "prefix",
+	"topic_metadata_refresh_interval": "30s",
+	"format":                          []interface{}{map[string]interface{}{"json": true}},
+	"envelope": []interface{}{
+		map[string]interface{}{
+			"upsert": true,
+			"upsert_options": []interface{}{
+				map[string]interface{}{
+					"value_decoding_errors": []interface{}{
+						map[string]interface{}{
+							"inline": []interface{}{map[string]interface{}{"enabled": true, "alias": "decoding_error"}},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestResourceSourceKafkaCreateOptions(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceKafka().Schema, inSourceKafkaOptions)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster" FROM KAFKA CONNECTION "materialize"."public"."kafka_conn" \(TOPIC 'topic', GROUP ID PREFIX 'prefix', TOPIC METADATA REFRESH INTERVAL '30s'\)
+			FORMAT JSON
+			ENVELOPE UPSERT \(VALUE DECODING ERRORS = \(INLINE AS "decoding_error"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceKafkaInvalidEnvelopeDiff(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "source",
+		"kafka_connection": []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+		"topic":            "topic",
+		"format":           []interface{}{map[string]interface{}{"json": true}},
+		"envelope":         []interface{}{map[string]interface{}{"debezium": true}},
+	})
+
+	_, err := SourceKafka().Diff(context.TODO(), nil, config, nil)
+	r.EqualError(err, "envelope debezium requires an avro format")
+
+	_, err = SourceTableKafka().Diff(context.TODO(), nil, config, nil)
+	r.EqualError(err, "envelope debezium requires an avro format")
+
+	// Sources without a format decode keys and values as bytes
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "source",
+		"kafka_connection": []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+		"topic":            "topic",
+		"envelope":         []interface{}{map[string]interface{}{"upsert": true}},
+	})
+
+	_, err = SourceKafka().Diff(context.TODO(), nil, config, nil)
+	r.NoError(err)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jmoiron/sqlx"
@@ -63,7 +65,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"auction_options", "marketing_options", "tpch_options"},
+		ConflictsWith: []string{"auction_options", "marketing_options", "tpch_options", "key_value_options", "clock_options", "datums_options"},
 	},
 	"auction_options": {
 		Description: "Auction Options.",
@@ -78,7 +80,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"counter_options", "marketing_options", "tpch_options"},
+		ConflictsWith: []string{"counter_options", "marketing_options", "tpch_options", "key_value_options", "clock_options", "datums_options"},
 	},
 	"marketing_options": {
 		Description: "Marketing Options.",
@@ -93,7 +95,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"counter_options", "auction_options", "tpch_options"},
+		ConflictsWith: []string{"counter_options", "auction_options", "tpch_options", "key_value_options", "clock_options", "datums_options"},
 	},
 	"tpch_options": {
 		Description: "TPCH Options.",
@@ -108,11 +110,112 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options"},
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "key_value_options", "clock_options", "datums_options"},
 	},
-	"expose_progress": IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
-	"subsource":       SubsourceSchema(),
-	"ownership_role":  OwnershipRoleSchema(),
+	"key_value_options": {
+		Description: "Key Value Options.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Description: "The number of keys in the source. This must be divisible by `partitions` * `batch_size`.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"snapshot_rounds": {
+					Description: "The number of rounds of data (1 update per key in each round) to produce as the source starts up.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"transactional_snapshot": {
+					Description: "Whether to emit the snapshot as a single transaction.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					ForceNew:    true,
+				},
+				"value_size": {
+					Description: "The number of bytes in each value.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"seed": {
+					Description: "A per-source u64 seed for seeding the random data.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"partitions": {
+					Description: "The number of partitions to spread the keys across.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"batch_size": {
+					Description: "The number of keys per partition to produce in each update.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"tick_interval": tick_interval,
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "tpch_options", "clock_options", "datums_options"},
+	},
+	"clock_options": {
+		Description: "Clock Options.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tick_interval": tick_interval,
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "tpch_options", "key_value_options", "datums_options"},
+	},
+	"datums_options": {
+		Description: "Datums Options.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tick_interval": tick_interval,
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "tpch_options", "key_value_options", "clock_options"},
+	},
+	"as_of": {
+		Description: "Start the generator at the given tick, skipping the data that would have been produced before it.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"up_to": {
+		Description: "Stop the generator after the given tick.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"expose_progress":   IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
+	"subsource":         SubsourceSchema(),
+	"ownership_role":    OwnershipRoleSchema(),
+	"status":            StatusSchema("source"),
+	"error":             StatusErrorSchema("source"),
+	"wait_for_running":  WaitForRunningSchema("source"),
+	"wait_for_snapshot": WaitForSnapshotSchema(),
 }
 
 func SourceLoadgen() *schema.Resource {
@@ -128,10 +231,36 @@ func SourceLoadgen() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(validSize, sourceLoadgenOptionsDiff),
+
 		Schema: sourceLoadgenSchema,
 	}
 }
 
+// Report missing generator options and an empty tick range at plan time
+func sourceLoadgenOptionsDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Get("load_generator_type").(string) == "KEY VALUE" && d.NewValueKnown("key_value_options") {
+		if v, ok := d.GetOk("key_value_options"); !ok || len(v.([]interface{})) == 0 {
+			return fmt.Errorf("key_value_options must be set for KEY VALUE load generators")
+		}
+	}
+
+	if !d.NewValueKnown("as_of") || !d.NewValueKnown("up_to") {
+		return nil
+	}
+
+	asOf, upTo := d.Get("as_of").(int), d.Get("up_to").(int)
+	if upTo != 0 && asOf > upTo {
+		return fmt.Errorf("as_of %d must not be greater than up_to %d", asOf, upTo)
+	}
+
+	return nil
+}
+
 func sourceLoadgenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -177,6 +306,29 @@ func sourceLoadgenCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		b.TPCHOptions(o)
 	}
 
+	if v, ok := d.GetOk("key_value_options"); ok {
+		o := materialize.GetKeyValueOptionsStruct(v)
+		b.KeyValueOptions(o)
+	}
+
+	if v, ok := d.GetOk("clock_options"); ok {
+		o := materialize.GetClockOptionsStruct(v)
+		b.ClockOptions(o)
+	}
+
+	if v, ok := d.GetOk("datums_options"); ok {
+		o := materialize.GetDatumsOptionsStruct(v)
+		b.DatumsOptions(o)
+	}
+
+	if v, ok := d.GetOk("as_of"); ok {
+		b.AsOf(v.(int))
+	}
+
+	if v, ok := d.GetOk("up_to"); ok {
+		b.UpTo(v.(int))
+	}
+
 	// create resource
 	if err := b.Create(); err != nil {
 		return diag.FromErr(err)
@@ -211,5 +363,9 @@ func sourceLoadgenCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if err := sourceWait(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return sourceRead(ctx, d, meta)
 }
@@ -8,6 +8,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -60,3 +61,160 @@ func TestResourceSourceLoadgenCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceSourceLoadgenCreateWaitForSnapshot(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                "source",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"cluster_name":        "cluster",
+		"load_generator_type": "COUNTER",
+		"wait_for_snapshot":   true,
+	}
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM LOAD GENERATOR COUNTER;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Wait, starting before running with a committed snapshot
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceStatusScan(mock, pp, "starting", "")
+		testhelpers.MockSourceStatusScan(mock, pp, "running", "")
+		testhelpers.MockSourceSnapshotScan(mock, `WHERE mz_source_statistics.id = 'u1'`, true)
+
+		// Query Params
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceLoadgenCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("running", d.Get("status"))
+	})
+}
+
+func TestResourceSourceLoadgenCreateWaitForRunningStalled(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                "source",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"cluster_name":        "cluster",
+		"load_generator_type": "COUNTER",
+		"wait_for_running":    true,
+	}
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM LOAD GENERATOR COUNTER;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Wait
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceStatusScan(mock, pp, "stalled", "upstream authentication failed")
+
+		diags := sourceLoadgenCreate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Equal(`source "database"."schema"."source" is stalled: upstream authentication failed`, diags[0].Summary)
+	})
+}
+
+var inSourceLoadgenKeyValue = map[string]interface{}{
+	"name":                "source",
+	"schema_name":         "schema",
+	"database_name":       "database",
+	"load_generator_type": "KEY VALUE",
+	"key_value_options": []interface{}{map[string]interface{}{
+		"keys":            128,
+		"snapshot_rounds": 2,
+		"value_size":      64,
+		"seed":            42,
+		"partitions":      4,
+		"batch_size":      8,
+	}},
+	"up_to": 1000,
+}
+
+func TestResourceSourceLoadgenKeyValueCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, inSourceLoadgenKeyValue)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			FROM LOAD GENERATOR KEY VALUE
+			\(KEYS 128, SNAPSHOT ROUNDS 2, TRANSACTIONAL SNAPSHOT true, VALUE SIZE 64, SEED 42, PARTITIONS 4, BATCH SIZE 8, UP TO 1000\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceLoadgenCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceLoadgenOptionsDiff(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			config: map[string]interface{}{
+				"name":                "source",
+				"load_generator_type": "KEY VALUE",
+			},
+			err: "key_value_options must be set for KEY VALUE load generators",
+		},
+		{
+			config: map[string]interface{}{
+				"name":                "source",
+				"load_generator_type": "COUNTER",
+				"as_of":               100,
+				"up_to":               10,
+			},
+			err: "as_of 100 must not be greater than up_to 10",
+		},
+	}
+
+	for _, c := range cases {
+		_, err := SourceLoadgen().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(c.config), nil)
+		r.EqualError(err, c.err)
+	}
+
+	_, err := SourceLoadgen().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(inSourceLoadgenKeyValue), nil)
+	r.NoError(err)
+}
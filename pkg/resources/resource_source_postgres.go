@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -32,6 +33,7 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
+		Deprecated:  "Use `text_columns` within the `table` block instead.",
 	},
 	"table": {
 		Description: "Creates subsources for specific tables. If neither table or schema is specified, will default to ALL TABLES",
@@ -48,6 +50,18 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 					Type:        schema.TypeString,
 					Optional:    true,
 				},
+				"text_columns": {
+					Description: "Decode data as text for columns of the table that contain PostgreSQL types that are unsupported in Materialize. Changing the columns recreates the subsource.",
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+				},
+				"exclude_columns": {
+					Description: "Exclude columns of the table from the subsource. Changing the columns recreates the subsource.",
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+				},
 			},
 		},
 		Optional:      true,
@@ -63,9 +77,13 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		ConflictsWith: []string{"table"},
 	},
-	"expose_progress": IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
-	"subsource":       SubsourceSchema(),
-	"ownership_role":  OwnershipRoleSchema(),
+	"expose_progress":   IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
+	"subsource":         SubsourceSchema(),
+	"ownership_role":    OwnershipRoleSchema(),
+	"status":            StatusSchema("source"),
+	"error":             StatusErrorSchema("source"),
+	"wait_for_running":  WaitForRunningSchema("source"),
+	"wait_for_snapshot": WaitForSnapshotSchema(),
 }
 
 func SourcePostgres() *schema.Resource {
@@ -81,6 +99,12 @@ func SourcePostgres() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: validSize,
+
 		Schema: sourcePostgresSchema,
 	}
 }
@@ -164,6 +188,10 @@ func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
+	if err := sourceWait(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return sourceRead(ctx, d, meta)
 }
 
@@ -205,6 +233,15 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		addTables := materialize.DiffTableStructs(nt.([]interface{}), ot.([]interface{}))
 		dropTables := materialize.DiffTableStructs(ot.([]interface{}), nt.([]interface{}))
 
+		// Subsources cannot be altered so changed tables are dropped under their
+		// previous alias and added again
+		if changedTables := materialize.ChangedTableStructs(ot.([]interface{}), nt.([]interface{})); len(changedTables) > 0 {
+			if err := b.DropSubsource(changedTables); err != nil {
+				return diag.FromErr(err)
+			}
+			addTables = append(addTables, materialize.ChangedTableStructs(nt.([]interface{}), ot.([]interface{}))...)
+		}
+
 		if len(addTables) > 0 {
 			var colDiff []string
 			if d.HasChange("text_columns") {
@@ -232,6 +269,12 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	if d.HasChanges("wait_for_running", "wait_for_snapshot") {
+		if err := sourceWait(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return sourceRead(ctx, d, meta)
 }
 
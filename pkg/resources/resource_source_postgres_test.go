@@ -9,6 +9,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -135,6 +136,150 @@ func TestResourceSourcePostgresUpdate(t *testing.T) {
 	})
 }
 
+var inSourcePostgresTableColumns = map[string]interface{}{
+	"name":          "source",
+	"schema_name":   "schema",
+	"database_name": "database",
+	"postgres_connection": []interface{}{
+		map[string]interface{}{
+			"name": "pg_connection",
+		},
+	},
+	"publication": "mz_source",
+	"table": []interface{}{
+		map[string]interface{}{"name": "name1", "alias": "alias", "text_columns": []interface{}{"column_1"}},
+		map[string]interface{}{"name": "name2", "text_columns": []interface{}{"column_2"}, "exclude_columns": []interface{}{"column_3"}},
+	},
+}
+
+func TestResourceSourcePostgresCreateTableColumns(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, inSourcePostgresTableColumns)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" FROM POSTGRES CONNECTION "materialize"."public"."pg_connection" \(PUBLICATION 'mz_source', TEXT COLUMNS \(name1.column_1, name2.column_2\), EXCLUDE COLUMNS \(name2.column_3\)\) FOR TABLES \(name1 AS alias, name2 AS name2\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourcePostgresCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourcePostgresUpdateTableColumns(t *testing.T) {
+	r := require.New(t)
+
+	s := &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                                  "u1",
+		"name":                                "source",
+		"schema_name":                         "schema",
+		"database_name":                       "database",
+		"publication":                         "mz_source",
+		"postgres_connection.#":               "1",
+		"postgres_connection.0.name":          "pg_connection",
+		"postgres_connection.0.schema_name":   "public",
+		"postgres_connection.0.database_name": "materialize",
+		"table.#":                             "1",
+		"table.0.name":                        "name1",
+		"table.0.alias":                       "alias",
+		"table.0.text_columns.#":              "1",
+		"table.0.text_columns.0":              "column_0",
+	}}
+	c := terraform.NewResourceConfigRaw(inSourcePostgresTableColumns)
+
+	diff, err := SourcePostgres().Diff(context.TODO(), s, c, nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+	d, err := schema.InternalMap(SourcePostgres().Schema).Data(s, diff)
+	r.NoError(err)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."source" DROP SUBSOURCE "alias";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."source" ADD SUBSOURCE "name2", "name1" AS "alias" WITH \(TEXT COLUMNS \[name2.column_2, name1.column_1\], EXCLUDE COLUMNS \[name2.column_3\]\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourcePostgresUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourcePostgresUpdateTableAliasColumns(t *testing.T) {
+	r := require.New(t)
+
+	s := &terraform.InstanceState{ID: "u1", Attributes: map[string]string{
+		"id":                                  "u1",
+		"name":                                "source",
+		"schema_name":                         "schema",
+		"database_name":                       "database",
+		"publication":                         "mz_source",
+		"postgres_connection.#":               "1",
+		"postgres_connection.0.name":          "pg_connection",
+		"postgres_connection.0.schema_name":   "public",
+		"postgres_connection.0.database_name": "materialize",
+		"table.#":                             "1",
+		"table.0.name":                        "name1",
+		"table.0.alias":                       "old_alias",
+		"table.0.text_columns.#":              "1",
+		"table.0.text_columns.0":              "column_0",
+	}}
+	c := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "source",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"postgres_connection": []interface{}{map[string]interface{}{"name": "pg_connection"}},
+		"publication":         "mz_source",
+		"table": []interface{}{
+			map[string]interface{}{"name": "name1", "alias": "alias", "text_columns": []interface{}{"column_1"}},
+		},
+	})
+
+	diff, err := SourcePostgres().Diff(context.TODO(), s, c, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(SourcePostgres().Schema).Data(s, diff)
+	r.NoError(err)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// The subsource is dropped under its previous alias
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."source" DROP SUBSOURCE "old_alias";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."source" ADD SUBSOURCE "name1" AS "alias" WITH \(TEXT COLUMNS \[name1.column_1\]\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourcePostgresUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestDiffTextColumns(t *testing.T) {
 	arr1 := []interface{}{"t1.column_1", "t2.column_2"}
 	arr2 := []interface{}{"t1.column_1", "t3.column_2"}
@@ -0,0 +1,182 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+func sourceTableUpstreamNameSchema(description string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeString,
+		Required:    required,
+		Optional:    !required,
+		Computed:    !required,
+		ForceNew:    true,
+	}
+}
+
+func sourceTableColumnsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	}
+}
+
+func sourceTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s, err := materialize.ScanSourceTable(meta.(*sqlx.DB), utils.ExtractId(d.Id()))
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(s.TableId.String))
+
+	if err := d.Set("name", s.TableName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", s.SchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("database_name", s.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	source := []interface{}{
+		map[string]interface{}{
+			"name":          s.SourceName.String,
+			"schema_name":   s.SourceSchemaName.String,
+			"database_name": s.SourceDatabaseName.String,
+		},
+	}
+	if err := d.Set("source", source); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("upstream_name", s.UpstreamName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Kafka topics are not namespaced by an upstream schema
+	if s.SourceType.String != "kafka" {
+		if err := d.Set("upstream_schema_name", s.UpstreamSchemaName.String); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	qn := materialize.QualifiedName(s.DatabaseName.String, s.SchemaName.String, s.TableName.String)
+	if err := d.Set("qualified_sql_name", qn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Ownership and comments are applied after the table is created
+func sourceTableCreateFinalize(ctx context.Context, d *schema.ResourceData, meta interface{}, o materialize.MaterializeObject, b *materialize.SourceTable) diag.Diagnostics {
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// object comment
+	if v, ok := d.GetOk("comment"); ok {
+		comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := comment.Object(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.SourceTableId(meta.(*sqlx.DB), o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.TransformIdWithRegion(i))
+
+	return sourceTableRead(ctx, d, meta)
+}
+
+func sourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		o := materialize.MaterializeObject{ObjectType: "TABLE", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewSourceTable(meta.(*sqlx.DB), o)
+
+		if err := b.Rename(newName.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ownership_role") {
+		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Alter(newRole.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		_, newComment := d.GetChange("comment")
+		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+
+		if err := b.Object(newComment.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return sourceTableRead(ctx, d, meta)
+}
+
+func sourceTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTable(meta.(*sqlx.DB), o)
+
+	if err := b.Drop(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
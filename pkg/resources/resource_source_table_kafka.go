@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceTableKafkaSchema = map[string]*schema.Schema{
+	"name":                    ObjectNameSchema("table", true, false),
+	"schema_name":             SchemaNameSchema("table", false),
+	"database_name":           DatabaseNameSchema("table", false),
+	"qualified_sql_name":      QualifiedNameSchema("table"),
+	"comment":                 CommentSchema(false),
+	"source":                  IdentifierSchema("source", "The Kafka source to create the table from.", true),
+	"upstream_name":           sourceTableUpstreamNameSchema("The Kafka topic to read from. Defaults to the topic of the source.", false),
+	"include_key":             sourceKafkaSchema["include_key"],
+	"include_key_alias":       sourceKafkaSchema["include_key_alias"],
+	"include_headers":         sourceKafkaSchema["include_headers"],
+	"include_headers_alias":   sourceKafkaSchema["include_headers_alias"],
+	"include_partition":       sourceKafkaSchema["include_partition"],
+	"include_partition_alias": sourceKafkaSchema["include_partition_alias"],
+	"include_offset":          sourceKafkaSchema["include_offset"],
+	"include_offset_alias":    sourceKafkaSchema["include_offset_alias"],
+	"include_timestamp":       sourceKafkaSchema["include_timestamp"],
+	"include_timestamp_alias": sourceKafkaSchema["include_timestamp_alias"],
+	"format":                  FormatSpecSchema("format", "How to decode raw bytes from different formats into data structures Materialize can understand at runtime.", false),
+	"key_format":              FormatSpecSchema("key_format", "Set the key format explicitly.", false),
+	"value_format":            FormatSpecSchema("value_format", "Set the value format explicitly.", false),
+	"envelope":                sourceKafkaSchema["envelope"],
+	"ownership_role":          OwnershipRoleSchema(),
+}
+
+func SourceTableKafka() *schema.Resource {
+	return &schema.Resource{
+		Description: "A table created from a Kafka source, with its own format and envelope. Privileges can be granted with `materialize_table_grant`.",
+
+		CreateContext: sourceTableKafkaCreate,
+		ReadContext:   sourceTableRead,
+		UpdateContext: sourceTableUpdate,
+		DeleteContext: sourceTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: sourceKafkaFormatDiff,
+
+		Schema: sourceTableKafkaSchema,
+	}
+}
+
+func sourceTableKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTableKafkaBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("source"); ok {
+		source := materialize.GetIdentifierSchemaStruct(v)
+		b.Source(source)
+	}
+
+	if v, ok := d.GetOk("upstream_name"); ok {
+		b.UpstreamName(v.(string))
+	}
+
+	if v, ok := d.GetOk("include_key"); ok && v.(bool) {
+		if alias, ok := d.GetOk("include_key_alias"); ok {
+			b.IncludeKeyAlias(alias.(string))
+		} else {
+			b.IncludeKey()
+		}
+	}
+
+	if v, ok := d.GetOk("include_partition"); ok && v.(bool) {
+		if alias, ok := d.GetOk("include_partition_alias"); ok {
+			b.IncludePartitionAlias(alias.(string))
+		} else {
+			b.IncludePartition()
+		}
+	}
+
+	if v, ok := d.GetOk("include_offset"); ok && v.(bool) {
+		if alias, ok := d.GetOk("include_offset_alias"); ok {
+			b.IncludeOffsetAlias(alias.(string))
+		} else {
+			b.IncludeOffset()
+		}
+	}
+
+	if v, ok := d.GetOk("include_timestamp"); ok && v.(bool) {
+		if alias, ok := d.GetOk("include_timestamp_alias"); ok {
+			b.IncludeTimestampAlias(alias.(string))
+		} else {
+			b.IncludeTimestamp()
+		}
+	}
+
+	if v, ok := d.GetOk("include_headers"); ok && v.(bool) {
+		if alias, ok := d.GetOk("include_headers_alias"); ok {
+			b.IncludeHeadersAlias(alias.(string))
+		} else {
+			b.IncludeHeaders()
+		}
+	}
+
+	if v, ok := d.GetOk("format"); ok {
+		format := materialize.GetFormatSpecStruc(v)
+		b.Format(format)
+	}
+
+	if v, ok := d.GetOk("key_format"); ok {
+		format := materialize.GetFormatSpecStruc(v)
+		b.KeyFormat(format)
+	}
+
+	if v, ok := d.GetOk("value_format"); ok {
+		format := materialize.GetFormatSpecStruc(v)
+		b.ValueFormat(format)
+	}
+
+	if v, ok := d.GetOk("envelope"); ok {
+		envelope := materialize.GetSourceKafkaEnelopeStruct(v)
+		b.Envelope(envelope)
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return sourceTableCreateFinalize(ctx, d, meta, o, &b.SourceTable)
+}
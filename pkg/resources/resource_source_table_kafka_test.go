@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inSourceTableKafka = map[string]interface{}{
+	"name":              "table",
+	"schema_name":       "schema",
+	"database_name":     "database",
+	"source":            []interface{}{map[string]interface{}{"name": "source"}},
+	"upstream_name":     "topic",
+	"include_key":       true,
+	"include_key_alias": "key",
+	"include_offset":    true,
+	"format":            []interface{}{map[string]interface{}{"json": true}},
+	"envelope":          []interface{}{map[string]interface{}{"none": true}},
+}
+
+func TestResourceSourceTableKafkaCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceTableKafka().Schema, inSourceTableKafka)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table"
+			FROM SOURCE "materialize"."public"."source"
+			\(REFERENCE "topic"\)
+			FORMAT JSON
+			INCLUDE KEY AS key, OFFSET
+			ENVELOPE NONE;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockSourceTableScan(mock, ip, "kafka")
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockSourceTableScan(mock, pp, "kafka")
+
+		if err := sourceTableKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
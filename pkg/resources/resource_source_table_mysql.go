@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var sourceTableMySQLSchema = map[string]*schema.Schema{
+	"name":                 ObjectNameSchema("table", true, false),
+	"schema_name":          SchemaNameSchema("table", false),
+	"database_name":        DatabaseNameSchema("table", false),
+	"qualified_sql_name":   QualifiedNameSchema("table"),
+	"comment":              CommentSchema(false),
+	"source":               IdentifierSchema("source", "The MySQL source to create the table from.", true),
+	"upstream_name":        sourceTableUpstreamNameSchema("The name of the table in the upstream MySQL server.", true),
+	"upstream_schema_name": sourceTableUpstreamNameSchema("The database of the table in the upstream MySQL server.", true),
+	"text_columns":         sourceTableColumnsSchema("Columns to decode as text because they contain MySQL types that are unsupported in Materialize."),
+	"exclude_columns":      sourceTableColumnsSchema("Columns of the upstream table to exclude from the table."),
+	"ownership_role":       OwnershipRoleSchema(),
+}
+
+func SourceTableMySQL() *schema.Resource {
+	return &schema.Resource{
+		Description: "A table created from a MySQL source, replicating a single upstream table. Privileges can be granted with `materialize_table_grant`.",
+
+		CreateContext: sourceTableMySQLCreate,
+		ReadContext:   sourceTableRead,
+		UpdateContext: sourceTableUpdate,
+		DeleteContext: sourceTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: sourceTableMySQLSchema,
+	}
+}
+
+func sourceTableMySQLCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTableMySQLBuilder(meta.(*sqlx.DB), o)
+
+	if v, ok := d.GetOk("source"); ok {
+		source := materialize.GetIdentifierSchemaStruct(v)
+		b.Source(source)
+	}
+
+	if v, ok := d.GetOk("upstream_name"); ok {
+		b.UpstreamName(v.(string))
+	}
+
+	if v, ok := d.GetOk("upstream_schema_name"); ok {
+		b.UpstreamSchemaName(v.(string))
+	}
+
+	if v, ok := d.GetOk("text_columns"); ok {
+		b.TextColumns(materialize.GetSliceValueString(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("exclude_columns"); ok {
+		b.ExcludeColumns(materialize.GetSliceValueString(v.([]interface{})))
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return sourceTableCreateFinalize(ctx, d, meta, o, &b.SourceTable)
+}
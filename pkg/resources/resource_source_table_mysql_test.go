@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inSourceTableMySQL = map[string]interface{}{
+	"name":                 "table",
+	"schema_name":          "schema",
+	"database_name":        "database",
+	"source":               []interface{}{map[string]interface{}{"name": "source"}},
+	"upstream_name":        "upstream_table",
+	"upstream_schema_name": "upstream_schema",
+	"exclude_columns":      []interface{}{"column_1", "column_2"},
+}
+
+func TestResourceSourceTableMySQLCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceTableMySQL().Schema, inSourceTableMySQL)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table"
+			FROM SOURCE "materialize"."public"."source"
+			\(REFERENCE "upstream_schema"."upstream_table"\)
+			WITH \(EXCLUDE COLUMNS \("column_1", "column_2"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockSourceTableScan(mock, ip, "mysql")
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockSourceTableScan(mock, pp, "mysql")
+
+		if err := sourceTableMySQLCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
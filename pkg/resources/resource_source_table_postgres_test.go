@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inSourceTablePostgres = map[string]interface{}{
+	"name":                 "table",
+	"schema_name":          "schema",
+	"database_name":        "database",
+	"source":               []interface{}{map[string]interface{}{"name": "source"}},
+	"upstream_name":        "upstream_table",
+	"upstream_schema_name": "upstream_schema",
+	"text_columns":         []interface{}{"column_1"},
+	"exclude_columns":      []interface{}{"column_2"},
+	"ownership_role":       "joe",
+	"comment":              "object comment",
+}
+
+func TestResourceSourceTablePostgresCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceTablePostgres().Schema, inSourceTablePostgres)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table"
+			FROM SOURCE "materialize"."public"."source"
+			\(REFERENCE "upstream_schema"."upstream_table"\)
+			WITH \(TEXT COLUMNS \("column_1"\), EXCLUDE COLUMNS \("column_2"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Ownership
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."table" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Comment
+		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."table" IS 'object comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockSourceTableScan(mock, ip, "postgres")
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockSourceTableScan(mock, pp, "postgres")
+
+		if err := sourceTablePostgresCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("upstream_table", d.Get("upstream_name"))
+		r.Equal("upstream_schema", d.Get("upstream_schema_name"))
+	})
+}
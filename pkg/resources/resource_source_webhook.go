@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -101,9 +102,10 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"check_options": {
-		Description: "The check options for the webhook.",
-		Type:        schema.TypeList,
-		Optional:    true,
+		Description:   "The check options for the webhook.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"validation_preset"},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"field": {
@@ -143,13 +145,58 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"check_expression": {
-		Description: "The check expression for the webhook.",
+		Description:   "The check expression for the webhook.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"validation_preset"},
+	},
+	"validation_preset": {
+		Description: "Validate requests with the check options and expression of a known webhook provider instead of `check_options` and `check_expression`.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Description:  fmt.Sprintf("The webhook provider to validate requests for: %s.", webhookValidationPresets),
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(webhookValidationPresets, true),
+				},
+				"secret": validationPresetSecretSchema(),
+				"header": {
+					Description: "The header containing the hex-encoded signature. Required for `HMAC_SHA256`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"check_options", "check_expression"},
+	},
+	"url": {
+		Description: "The URL to send requests to the webhook source.",
 		Type:        schema.TypeString,
-		Optional:    true,
-		ForceNew:    true,
+		Computed:    true,
 	},
 	"subsource":      SubsourceSchema(),
 	"ownership_role": OwnershipRoleSchema(),
+	"status":         StatusSchema("source"),
+	"error":          StatusErrorSchema("source"),
+}
+
+// The check of a webhook source cannot be altered, so changing any part of the
+// secret replaces the source
+func validationPresetSecretSchema() *schema.Schema {
+	s := IdentifierSchema("secret", "The secret shared with the webhook provider to sign requests.", true)
+	for _, f := range s.Elem.(*schema.Resource).Schema {
+		f.ForceNew = true
+	}
+	return s
 }
 
 func SourceWebhook() *schema.Resource {
@@ -157,18 +204,28 @@ func SourceWebhook() *schema.Resource {
 		Description: "**Private Preview** A webhook source describes a webhook you want Materialize to read data from.",
 
 		CreateContext: sourceWebhookCreate,
-		ReadContext:   sourceRead,
-		UpdateContext: sourceUpdate,
+		ReadContext:   sourceWebhookRead,
+		UpdateContext: sourceWebhookUpdate,
 		DeleteContext: sourceDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: sourceWebhookUrlDiff,
+
 		Schema: sourceWebhookSchema,
 	}
 }
 
+// The URL is derived from the qualified name and changes when the source is renamed
+func sourceWebhookUrlDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChanges("name", "schema_name", "database_name") {
+		return d.SetNewComputed("url")
+	}
+	return nil
+}
+
 func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -241,6 +298,17 @@ func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 		b.CheckOptions(options)
 	}
+
+	if v, ok := d.GetOk("validation_preset"); ok {
+		u := v.([]interface{})[0].(map[string]interface{})
+		secret := materialize.GetIdentifierSchemaStruct(u["secret"])
+		options, expression, err := materialize.WebhookValidationPreset(u["type"].(string), secret, u["header"].(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		b.CheckOptions(options).CheckExpression(expression)
+	}
+
 	// Create resource
 	if err := b.Create(); err != nil {
 		return diag.FromErr(err)
@@ -275,5 +343,32 @@ func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	d.SetId(utils.TransformIdWithRegion(i))
 
-	return sourceRead(ctx, d, meta)
+	return sourceWebhookRead(ctx, d, meta)
+}
+
+func sourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sourceRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	return setWebhookUrl(d)
+}
+
+func sourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sourceUpdate(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	return setWebhookUrl(d)
+}
+
+func setWebhookUrl(d *schema.ResourceData) diag.Diagnostics {
+	o := materialize.MaterializeObject{
+		Name:         d.Get("name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+	}
+	if err := d.Set("url", materialize.WebhookUrl(utils.Host, o)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -68,3 +70,94 @@ func TestResourceSourceWebhookCreate(t *testing.T) {
 		}
 	})
 }
+
+var inSourceWebhookValidationPreset = map[string]interface{}{
+	"name":          "webhook_source",
+	"schema_name":   "schema",
+	"database_name": "database",
+	"cluster_name":  "cluster",
+	"body_format":   "JSON",
+	"validation_preset": []interface{}{
+		map[string]interface{}{
+			"type":   "GITHUB",
+			"secret": []interface{}{map[string]interface{}{"name": "secret"}},
+		},
+	},
+}
+
+func TestResourceSourceWebhookCreateValidationPreset(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceWebhook().Schema, inSourceWebhookValidationPreset)
+	r.NotNil(d)
+
+	utils.SetRegionFromHostname("materialize")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK \( WITH \(BODY BYTES, HEADERS, SECRET "materialize"."public"."secret" AS secret BYTES\) constant_time_eq\(decode\(split_part\(headers->'x-hub-signature-256', '=', 2\), 'hex'\), hmac\(body, secret, 'sha256'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'webhook_source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceWebhookCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("https://materialize/api/webhook/database/schema/source", d.Get("url"))
+	})
+}
+
+func TestResourceSourceWebhookValidationPresetDiff(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "aws/us-east-1:u1", Attributes: map[string]string{
+		"id":                                "aws/us-east-1:u1",
+		"name":                              "webhook_source",
+		"schema_name":                       "schema",
+		"database_name":                     "database",
+		"cluster_name":                      "cluster",
+		"body_format":                       "JSON",
+		"validation_preset.#":               "1",
+		"validation_preset.0.type":          "GITHUB",
+		"validation_preset.0.header":        "",
+		"validation_preset.0.secret.#":      "1",
+		"validation_preset.0.secret.0.name": "secret",
+		"validation_preset.0.secret.0.schema_name":   "public",
+		"validation_preset.0.secret.0.database_name": "materialize",
+		"url": "https://materialize/api/webhook/database/schema/webhook_source",
+	}}
+
+	cases := []map[string]interface{}{
+		{"type": "SEGMENT", "secret": []interface{}{map[string]interface{}{"name": "secret"}}},
+		{"type": "GITHUB", "secret": []interface{}{map[string]interface{}{"name": "other_secret"}}},
+		{"type": "GITHUB", "secret": []interface{}{map[string]interface{}{"name": "secret", "schema_name": "other"}}},
+		{"type": "GITHUB", "header": "x-signature", "secret": []interface{}{map[string]interface{}{"name": "secret"}}},
+	}
+
+	for _, preset := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":              "webhook_source",
+			"schema_name":       "schema",
+			"database_name":     "database",
+			"cluster_name":      "cluster",
+			"body_format":       "JSON",
+			"validation_preset": []interface{}{preset},
+		})
+
+		diff, err := SourceWebhook().Diff(context.TODO(), state, config, nil)
+		r.NoError(err)
+		r.NotNil(diff)
+		r.True(diff.RequiresNew(), "changing the validation preset to %v must replace the source", preset)
+	}
+}
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+var systemParameterSchema = map[string]*schema.Schema{
+	"name": {
+		Description: "The name of the system parameter, such as `max_tables` or `max_sources`.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"value": {
+		Description: "The value of the system parameter.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+}
+
+func SystemParameter() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sets the system-wide default value of a configuration parameter. Requires superuser privileges.",
+
+		CreateContext: systemParameterCreate,
+		ReadContext:   systemParameterRead,
+		UpdateContext: systemParameterUpdate,
+		DeleteContext: systemParameterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: systemParameterSchema,
+	}
+}
+
+// Surface a clear diagnostic if the user is not permitted to run ALTER SYSTEM
+func systemParameterDiag(err error, parameterName string) diag.Diagnostics {
+	if strings.Contains(err.Error(), "SQLSTATE 42501") {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Insufficient privileges to alter system parameter %s", parameterName),
+			Detail:   fmt.Sprintf("Altering system parameters requires a superuser such as mz_system. %s", err.Error()),
+		}}
+	}
+	return diag.FromErr(err)
+}
+
+func systemParameterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+	parameterName := utils.ExtractId(i)
+
+	s, err := materialize.ScanSystemParameter(meta.(*sqlx.DB), parameterName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(i))
+
+	if err := d.Set("name", parameterName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("value", s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func systemParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parameterName := d.Get("name").(string)
+	value := d.Get("value").(string)
+
+	b := materialize.NewSystemParameterBuilder(meta.(*sqlx.DB), parameterName)
+
+	if err := b.Set(value); err != nil {
+		return systemParameterDiag(err, parameterName)
+	}
+
+	// set id
+	d.SetId(utils.TransformIdWithRegion(parameterName))
+
+	return systemParameterRead(ctx, d, meta)
+}
+
+func systemParameterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parameterName := d.Get("name").(string)
+
+	if d.HasChange("value") {
+		_, newValue := d.GetChange("value")
+		b := materialize.NewSystemParameterBuilder(meta.(*sqlx.DB), parameterName)
+
+		if err := b.Set(newValue.(string)); err != nil {
+			return systemParameterDiag(err, parameterName)
+		}
+	}
+
+	return systemParameterRead(ctx, d, meta)
+}
+
+func systemParameterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parameterName := d.Get("name").(string)
+
+	b := materialize.NewSystemParameterBuilder(meta.(*sqlx.DB), parameterName)
+
+	if err := b.Reset(); err != nil {
+		return systemParameterDiag(err, parameterName)
+	}
+
+	return nil
+}
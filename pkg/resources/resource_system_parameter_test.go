@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var inSystemParameter = map[string]interface{}{
+	"name":  "max_tables",
+	"value": "100",
+}
+
+func TestResourceSystemParameterCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`ALTER SYSTEM SET max_tables = '100';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockSystemParameterScan(mock, "max_tables")
+
+		if err := systemParameterCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Id() != "aws/us-east-1:max_tables" {
+			t.Fatalf("unexpected id of %s", d.Id())
+		}
+	})
+}
+
+func TestResourceSystemParameterCreatePermissionDenied(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SYSTEM SET max_tables = '100';`,
+		).WillReturnError(errors.New("ERROR: permission denied to alter system (SQLSTATE 42501)"))
+
+		diags := systemParameterCreate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Equal("Insufficient privileges to alter system parameter max_tables", diags[0].Summary)
+	})
+}
+
+func TestResourceSystemParameterUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+
+	// Set current state
+	d.SetId("aws/us-east-1:max_tables")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER SYSTEM SET max_tables = '100';`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		testhelpers.MockSystemParameterScan(mock, "max_tables")
+
+		if err := systemParameterUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSystemParameterDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM RESET max_tables;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := systemParameterDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
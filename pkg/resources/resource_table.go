@@ -3,7 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -20,7 +22,7 @@ var tableSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("table"),
 	"comment":            CommentSchema(false),
 	"column": {
-		Description: "Column of the table.",
+		Description: "Column of the table. Columns that allow NULL values and have no default can be appended to the end of the list in place; any other column change requires the table to be replaced.",
 		Type:        schema.TypeList,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
@@ -28,32 +30,24 @@ var tableSchema = map[string]*schema.Schema{
 					Description: "The name of the column to be created in the table.",
 					Type:        schema.TypeString,
 					Required:    true,
-					ForceNew:    true,
 				},
 				"type": {
 					Description: "The data type of the column indicated by name.",
 					Type:        schema.TypeString,
 					Required:    true,
-					ForceNew:    true,
 					StateFunc: func(val any) string {
-						alias, ok := aliases[val.(string)]
-						if ok {
-							return alias
-						}
-						return val.(string)
+						return columnTypeAlias(val.(string))
 					},
 				},
 				"nullable": {
 					Description: "Do not allow the column to contain `NULL` values. Columns without this constraint can contain `NULL` values.",
 					Type:        schema.TypeBool,
-					ForceNew:    true,
 					Optional:    true,
 					Default:     false,
 				},
 				"default": {
 					Description: "A default value to use for the column in an INSERT statement if an explicit value is not provided. If not specified, `NULL` is assumed..",
 					Type:        schema.TypeString,
-					ForceNew:    true,
 					Optional:    true,
 					Default:     "NULL",
 				},
@@ -62,7 +56,6 @@ var tableSchema = map[string]*schema.Schema{
 		},
 		Required: true,
 		MinItems: 1,
-		ForceNew: true,
 	},
 	"ownership_role": OwnershipRoleSchema(),
 }
@@ -80,10 +73,73 @@ func Table() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: tableColumnDiff,
+
 		Schema: tableSchema,
 	}
 }
 
+func columnTypeAlias(t string) string {
+	alias, ok := aliases[t]
+	if ok {
+		return alias
+	}
+	return t
+}
+
+func columnHasDefault(c map[string]interface{}) bool {
+	d := c["default"].(string)
+	return d != "" && !strings.EqualFold(d, "NULL")
+}
+
+// Columns can only be appended in place if they are nullable and have no default.
+// Changes to existing columns still require the table to be replaced.
+func tableColumnDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("column") {
+		return nil
+	}
+
+	oldColumns, newColumns := d.GetChange("column")
+	oldColumnsList := oldColumns.([]interface{})
+	newColumnsList := newColumns.([]interface{})
+
+	if len(newColumnsList) < len(oldColumnsList) {
+		return d.ForceNew("column")
+	}
+
+	for index, newColMap := range newColumnsList {
+		newCol := newColMap.(map[string]interface{})
+
+		if index < len(oldColumnsList) {
+			oldCol := oldColumnsList[index].(map[string]interface{})
+
+			changed := map[string]bool{
+				"name":     newCol["name"] != oldCol["name"],
+				"type":     columnTypeAlias(newCol["type"].(string)) != columnTypeAlias(oldCol["type"].(string)),
+				"nullable": newCol["nullable"] != oldCol["nullable"],
+				"default":  columnHasDefault(newCol) != columnHasDefault(oldCol) || (columnHasDefault(newCol) && newCol["default"] != oldCol["default"]),
+			}
+			for attr, c := range changed {
+				if c {
+					if err := d.ForceNew(fmt.Sprintf("column.%d.%s", index, attr)); err != nil {
+						return err
+					}
+				}
+			}
+			continue
+		}
+
+		if newCol["nullable"].(bool) || columnHasDefault(newCol) {
+			return fmt.Errorf(
+				"column %q cannot be added to table %q in place: only columns that allow NULL values and have no default can be appended. Remove `nullable` and `default` from the column or replace the table with `terraform apply -replace`",
+				newCol["name"], d.Get("name"),
+			)
+		}
+	}
+
+	return nil
+}
+
 func tableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	i := d.Id()
 
@@ -256,6 +312,25 @@ func tableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 		for index, newColMap := range newColumnsList {
 			newCol := newColMap.(map[string]interface{})
+
+			// Columns appended to the end of the list are added in place
+			if index >= len(oldColumnsList) {
+				b := materialize.NewTableBuilder(meta.(*sqlx.DB), o)
+				c := materialize.GetTableColumnStruct([]interface{}{newCol})[0]
+
+				if err := b.AddColumn(c); err != nil {
+					return diag.FromErr(err)
+				}
+
+				if c.Comment != "" {
+					comment := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
+					if err := comment.Column(c.ColName, c.Comment); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+				continue
+			}
+
 			oldCol := oldColumnsList[index].(map[string]interface{})
 
 			// Check specifically if the column comment has changed.
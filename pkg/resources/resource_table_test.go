@@ -2,12 +2,14 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -91,6 +93,105 @@ func TestResourceTableReadIdMigration(t *testing.T) {
 	})
 }
 
+func TestResourceTableUpdateAddColumn(t *testing.T) {
+	r := require.New(t)
+
+	s := tableColumnState(map[string]string{"name": "column", "type": "text"})
+	c := tableColumnConfig(
+		map[string]interface{}{"name": "column", "type": "text"},
+		map[string]interface{}{"name": "new_column", "type": "int", "comment": "new column comment"},
+	)
+
+	diff, err := Table().Diff(context.TODO(), s, c, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(Table().Schema).Data(s, diff)
+	r.NoError(err)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."table" ADD COLUMN new_column int;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."table"."new_column" IS 'new column comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockTableScan(mock, pp)
+
+		// Query Columns
+		cp := `WHERE mz_columns.id = 'u1'`
+		testhelpers.MockTableColumnScan(mock, cp)
+
+		if err := tableUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func tableColumnState(columns ...map[string]string) *terraform.InstanceState {
+	a := map[string]string{
+		"id":            "u1",
+		"name":          "table",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"column.#":      fmt.Sprint(len(columns)),
+	}
+	for i, c := range columns {
+		a[fmt.Sprintf("column.%d.name", i)] = c["name"]
+		a[fmt.Sprintf("column.%d.type", i)] = c["type"]
+		a[fmt.Sprintf("column.%d.nullable", i)] = "false"
+		a[fmt.Sprintf("column.%d.default", i)] = "NULL"
+		a[fmt.Sprintf("column.%d.comment", i)] = ""
+	}
+	return &terraform.InstanceState{ID: "u1", Attributes: a}
+}
+
+func tableColumnConfig(columns ...interface{}) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "table",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"column":        columns,
+	})
+}
+
+func TestResourceTableDiffAppendColumn(t *testing.T) {
+	r := require.New(t)
+
+	s := tableColumnState(map[string]string{"name": "column", "type": "text"})
+	c := tableColumnConfig(
+		map[string]interface{}{"name": "column", "type": "text"},
+		map[string]interface{}{"name": "new_column", "type": "text"},
+	)
+
+	diff, err := Table().Diff(context.TODO(), s, c, nil)
+	r.NoError(err)
+	r.False(diff.RequiresNew())
+}
+
+func TestResourceTableDiffAppendNotNullColumn(t *testing.T) {
+	r := require.New(t)
+
+	s := tableColumnState(map[string]string{"name": "column", "type": "text"})
+	c := tableColumnConfig(
+		map[string]interface{}{"name": "column", "type": "text"},
+		map[string]interface{}{"name": "new_column", "type": "text", "nullable": true},
+	)
+
+	_, err := Table().Diff(context.TODO(), s, c, nil)
+	r.ErrorContains(err, `column "new_column" cannot be added to table "table" in place`)
+}
+
+func TestResourceTableDiffModifyColumn(t *testing.T) {
+	r := require.New(t)
+
+	s := tableColumnState(map[string]string{"name": "column", "type": "text"})
+	c := tableColumnConfig(
+		map[string]interface{}{"name": "column", "type": "int"},
+	)
+
+	diff, err := Table().Diff(context.TODO(), s, c, nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+}
+
 func TestResourceTableDelete(t *testing.T) {
 	r := require.New(t)
 
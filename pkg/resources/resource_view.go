@@ -3,7 +3,9 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -20,10 +22,10 @@ var viewSchema = map[string]*schema.Schema{
 	"qualified_sql_name": QualifiedNameSchema("view"),
 	"comment":            CommentSchema(false),
 	"statement": {
-		Description: "The SQL statement for the view.",
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
+		Description:      "The SQL statement for the view. Changes are applied in place by creating the new definition under a temporary name, granting it the privileges of the view and swapping it in. Removing, renaming or retyping existing columns, or changing a view that other objects depend on, replaces the view instead. Differences in formatting, casing, qualification and type aliases from the definition stored by Materialize are ignored. The statement is only read from the stored definition on import, so changes made outside of Terraform are logged rather than reported in the plan.",
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: statementDiffSuppress,
 	},
 	"ownership_role": OwnershipRoleSchema(),
 }
@@ -41,10 +43,84 @@ func View() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: viewStatementDiff,
+
 		Schema: viewSchema,
 	}
 }
 
+// The view is only replaced when the new statement drops, renames or retypes
+// one of its columns. Appending columns keeps the existing signature intact.
+// Either way the previous view is dropped, so changes that cannot be applied
+// are reported at plan time.
+func viewStatementDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !statementChanged(d) || !d.NewValueKnown("statement") {
+		return nil
+	}
+
+	oldName, _ := d.GetChange("name")
+	qn := materialize.QualifiedName(d.Get("database_name").(string), d.Get("schema_name").(string), oldName.(string))
+
+	dependents, err := viewDependents(meta.(*sqlx.DB), utils.ExtractId(d.Id()))
+	if err != nil {
+		return err
+	}
+
+	if len(dependents) > 0 {
+		// Dependents reference the view itself, so they would keep using the
+		// replaced definition
+		log.Printf("[WARN] objects depend on view %s, replacing the view: %s", qn, strings.Join(dependents, ", "))
+		return d.ForceNew("statement")
+	}
+
+	newColumns, err := materialize.ListQueryColumns(meta.(*sqlx.DB), d.Get("statement").(string))
+	if materialize.IsUndefinedObjectError(err) {
+		// Objects created within the same apply cannot be checked at plan time
+		log.Printf("[DEBUG] unable to read columns of the new statement for %s, replacing the view: %s", qn, err)
+		return d.ForceNew("statement")
+	} else if err != nil {
+		return fmt.Errorf("invalid statement for view %s: %s", qn, err)
+	}
+
+	oldColumns, err := materialize.ListQueryColumns(meta.(*sqlx.DB), fmt.Sprintf("SELECT * FROM %s", qn))
+	if err != nil {
+		log.Printf("[DEBUG] unable to read columns of %s, skipping compatibility check: %s", qn, err)
+		return nil
+	}
+
+	if viewColumnsCompatible(oldColumns, newColumns) {
+		return nil
+	}
+	return d.ForceNew("statement")
+}
+
+func viewColumnsCompatible(oldColumns, newColumns []materialize.QueryColumnParams) bool {
+	if len(newColumns) < len(oldColumns) {
+		return false
+	}
+
+	for i, c := range oldColumns {
+		if c != newColumns[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Qualified names of the objects that depend on the view
+func viewDependents(conn *sqlx.DB, id string) ([]string, error) {
+	deps, err := materialize.ListDependents(conn, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []string
+	for _, dep := range deps {
+		dependents = append(dependents, materialize.QualifiedName(dep.DatabaseName.String, dep.SchemaName.String, dep.ObjectName.String))
+	}
+	return dependents, nil
+}
+
 func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	i := d.Id()
 
@@ -83,6 +159,10 @@ func viewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	if err := setStatement(d, s.Definition.String); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -152,20 +232,67 @@ func viewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		}
 	}
 
-	if d.HasChange("ownership_role") {
-		_, newRole := d.GetChange("ownership_role")
+	// The replaced view is a new object, so its privileges are granted on the
+	// temporary view and ownership and comment are reapplied
+	replaced := false
+	if d.HasChange("statement") {
+		b := materialize.NewViewBuilder(meta.(*sqlx.DB), o)
+		b.SelectStmt(d.Get("statement").(string))
+
+		if err := b.CreateTemporary(); err != nil {
+			return diag.FromErr(err)
+		}
+
+		privileges, err := materialize.ScanPrivileges(meta.(*sqlx.DB), "VIEW", utils.ExtractId(d.Id()))
+		if err == nil {
+			err = materialize.GrantPrivileges(meta.(*sqlx.DB), b.TemporaryObject(), privileges)
+		}
+		if err != nil {
+			b.DropTemporary()
+			return diag.FromErr(err)
+		}
+
+		dependents, err := viewDependents(meta.(*sqlx.DB), utils.ExtractId(d.Id()))
+		if err != nil {
+			b.DropTemporary()
+			return diag.FromErr(err)
+		}
+
+		if len(dependents) > 0 {
+			b.DropTemporary()
+			return diag.Errorf("view %s cannot be updated in place while other objects depend on it: %s", b.QualifiedName(), strings.Join(dependents, ", "))
+		}
+
+		// The temporary view is left in place when the view cannot be replaced
+		if err := b.Replace(); err != nil {
+			b.DropTemporary()
+			return diag.FromErr(err)
+		}
+
+		i, err := materialize.ViewId(meta.(*sqlx.DB), o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(utils.TransformIdWithRegion(i))
+
+		if err := b.DropBackup(); err != nil {
+			return diag.FromErr(err)
+		}
+		replaced = true
+	}
+
+	if v, ok := d.GetOk("ownership_role"); d.HasChange("ownership_role") || (replaced && ok) {
 		b := materialize.NewOwnershipBuilder(meta.(*sqlx.DB), o)
 
-		if err := b.Alter(newRole.(string)); err != nil {
+		if err := b.Alter(v.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChange("comment") {
-		_, newComment := d.GetChange("comment")
+	if v, ok := d.GetOk("comment"); d.HasChange("comment") || (replaced && ok) {
 		b := materialize.NewCommentBuilder(meta.(*sqlx.DB), o)
 
-		if err := b.Object(newComment.(string)); err != nil {
+		if err := b.Object(v.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
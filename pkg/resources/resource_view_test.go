@@ -8,6 +8,8 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jackc/pgx"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 )
@@ -68,15 +70,23 @@ func TestResourceViewReadIdMigration(t *testing.T) {
 
 func TestResourceViewUpdate(t *testing.T) {
 	r := require.New(t)
-	d := schema.TestResourceDataRaw(t, View().Schema, inView)
 
-	// Set current state
-	d.SetId("u1")
-	d.Set("name", "old_view")
-	r.NotNil(d)
+	state := &terraform.InstanceState{ID: "aws/us-east-1:u1", Attributes: map[string]string{
+		"id":            "aws/us-east-1:u1",
+		"name":          "old_view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 1 FROM 1",
+	}}
+	config := terraform.NewResourceConfigRaw(inView)
 
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
-		mock.ExpectExec(`ALTER VIEW "database"."schema"."" RENAME TO "view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		diff, err := View().Diff(context.TODO(), state, config, db)
+		r.NoError(err)
+		d, err := schema.InternalMap(View().Schema).Data(state, diff)
+		r.NoError(err)
+
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."old_view" RENAME TO "view";`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Query Params
 		pp := `WHERE mz_views.id = 'u1'`
@@ -88,6 +98,193 @@ func TestResourceViewUpdate(t *testing.T) {
 	})
 }
 
+var viewState = &terraform.InstanceState{ID: "aws/us-east-1:u1", Attributes: map[string]string{
+	"id":                 "aws/us-east-1:u1",
+	"name":               "view",
+	"schema_name":        "schema",
+	"database_name":      "database",
+	"qualified_sql_name": `"database"."schema"."view"`,
+	"statement":          "SELECT 1 AS a",
+	"ownership_role":     "joe",
+}}
+
+func TestResourceViewUpdateStatementInPlace(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 1 AS a, 2 AS b",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Appending a column keeps the signature compatible
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, nil)
+		testhelpers.MockQueryColumnScan(mock, `SELECT 1 AS a, 2 AS b`, [][]string{{"a", "INT4"}, {"b", "INT4"}})
+		testhelpers.MockQueryColumnScan(mock, `SELECT \* FROM "database"."schema"."view"`, [][]string{{"a", "INT4"}})
+
+		diff, err := View().Diff(context.TODO(), viewState, config, db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+
+		d, err := schema.InternalMap(View().Schema).Data(viewState, diff)
+		r.NoError(err)
+
+		mock.ExpectExec(`CREATE VIEW "database"."schema"."view_tmp_[0-9a-f]{8}" AS SELECT 1 AS a, 2 AS b;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// The privileges of the view are granted on its replacement
+		testhelpers.MockViewScan(mock, `WHERE mz_views.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		mock.ExpectExec(`GRANT USAGE, CREATE ON TABLE "database"."schema"."view_tmp_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u8'`)
+		mock.ExpectExec(`GRANT INSERT, SELECT, UPDATE ON TABLE "database"."schema"."view_tmp_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, nil)
+		mock.ExpectBegin()
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" RENAME TO "view_old_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view_tmp_[0-9a-f]{8}" RENAME TO "view";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		// The previous view is only dropped once the new one is in place
+		mock.ExpectExec(`DROP VIEW "database"."schema"."view_old_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// The replaced view is a new object
+		mock.ExpectExec(`ALTER VIEW "database"."schema"."view" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_views.id = 'u1'`
+		testhelpers.MockViewScan(mock, pp)
+
+		if err := viewUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceViewUpdateStatementIncompatible(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 'a' AS a",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Retyping a column replaces the view
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, nil)
+		testhelpers.MockQueryColumnScan(mock, `SELECT 'a' AS a`, [][]string{{"a", "TEXT"}})
+		testhelpers.MockQueryColumnScan(mock, `SELECT \* FROM "database"."schema"."view"`, [][]string{{"a", "INT4"}})
+
+		diff, err := View().Diff(context.TODO(), viewState, config, db)
+		r.NoError(err)
+		r.True(diff.RequiresNew())
+	})
+}
+
+func TestResourceViewUpdateStatementDependents(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 2 AS a",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Dependents would keep the previous definition, so the view is replaced
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"downstream", "view"}})
+
+		diff, err := View().Diff(context.TODO(), viewState, config, db)
+		r.NoError(err)
+		r.True(diff.RequiresNew())
+	})
+}
+
+func TestResourceViewUpdateStatementDependentsCreated(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 2 AS a",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, nil)
+		testhelpers.MockQueryColumnScan(mock, `SELECT 2 AS a`, [][]string{{"a", "INT4"}})
+		testhelpers.MockQueryColumnScan(mock, `SELECT \* FROM "database"."schema"."view"`, [][]string{{"a", "INT4"}})
+
+		diff, err := View().Diff(context.TODO(), viewState, config, db)
+		r.NoError(err)
+		r.False(diff.RequiresNew())
+
+		d, err := schema.InternalMap(View().Schema).Data(viewState, diff)
+		r.NoError(err)
+
+		// A dependent created after the plan leaves the view untouched
+		mock.ExpectExec(`CREATE VIEW "database"."schema"."view_tmp_[0-9a-f]{8}" AS SELECT 2 AS a;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		// The privileges of the view are granted on its replacement
+		testhelpers.MockViewScan(mock, `WHERE mz_views.id = 'u1'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		mock.ExpectExec(`GRANT USAGE, CREATE ON TABLE "database"."schema"."view_tmp_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u8'`)
+		mock.ExpectExec(`GRANT INSERT, SELECT, UPDATE ON TABLE "database"."schema"."view_tmp_[0-9a-f]{8}" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, [][]string{{"downstream", "view"}})
+		mock.ExpectExec(`DROP VIEW "database"."schema"."view_tmp_[0-9a-f]{8}";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := viewUpdate(context.TODO(), d, db)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, `"database"."schema"."downstream"`)
+	})
+}
+
+func TestResourceViewUpdateStatementInvalid(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT a FROM",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, nil)
+		mock.ExpectQuery(`SELECT \* FROM \(SELECT a FROM\) LIMIT 0;`).WillReturnError(pgx.PgError{Code: "42601", Message: "Expected identifier"})
+
+		_, err := View().Diff(context.TODO(), viewState, config, db)
+		r.ErrorContains(err, `invalid statement for view "database"."schema"."view"`)
+	})
+}
+
+func TestResourceViewUpdateStatementUndefinedObject(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT a FROM new_table",
+	})
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Objects created later in the same apply replace the view
+		testhelpers.MockDependentScan(mock, `WHERE mz_object_dependencies.referenced_object_id = 'u1'`, nil)
+		mock.ExpectQuery(`SELECT \* FROM \(SELECT a FROM new_table\) LIMIT 0;`).WillReturnError(pgx.PgError{Code: "42P01", Message: "unknown catalog item 'new_table'"})
+
+		diff, err := View().Diff(context.TODO(), viewState, config, db)
+		r.NoError(err)
+		r.True(diff.RequiresNew())
+	})
+}
+
 func TestResourceViewDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -72,12 +72,11 @@ func QualifiedNameSchema(resource string) *schema.Schema {
 
 func SizeSchema(resource string, required bool, forceNew bool) *schema.Schema {
 	return &schema.Schema{
-		Type:         schema.TypeString,
-		Description:  fmt.Sprintf("The size of the %s.", resource),
-		Required:     required,
-		Optional:     !required,
-		ForceNew:     forceNew,
-		ValidateFunc: validation.StringInSlice(replicaSizes, true),
+		Type:        schema.TypeString,
+		Description: fmt.Sprintf("The size of the %s. Validated against the sizes available in the region, see the `materialize_cluster_replica_sizes` data source.", resource),
+		Required:    required,
+		Optional:    !required,
+		ForceNew:    forceNew,
 	}
 }
 
@@ -354,6 +353,18 @@ func SinkFormatSpecSchema(elem string, description string, required bool) *schem
 					Optional:    true,
 					ForceNew:    true,
 				},
+				"text": {
+					Description: "Text format. Only supported for relations with a single column.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"bytes": {
+					Description: "Bytes format. Only supported for relations with a single column.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+				},
 			},
 		},
 		Required:    required,
@@ -404,7 +415,6 @@ func ObjectSizeSchema(objectType string) *schema.Schema {
 		Computed:      true,
 		AtLeastOneOf:  []string{"cluster_name", "size"},
 		ConflictsWith: []string{"cluster_name"},
-		ValidateFunc:  validation.StringInSlice(sourceSizes, true),
 	}
 }
 
@@ -512,3 +522,46 @@ func CommentSchema(forceNew bool) *schema.Schema {
 		ForceNew:    forceNew,
 	}
 }
+
+func WaitUntilReadySchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Wait for the %s to be hydrated on every replica of its cluster before completing, for up to the `create` timeout. Replicas that are still hydrating are reported if the timeout expires.", resource),
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func StatusSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The status of the %s: `starting`, `running`, `paused`, `stalled`, `failed` or `dropped`.", resource),
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+}
+
+func StatusErrorSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The error reported by the %s when it is `stalled` or `failed`.", resource),
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+}
+
+func WaitForRunningSchema(resource string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Wait for the %[1]s to be `running` before completing, for up to the `create` timeout. The apply fails with the error reported by the %[1]s if it stalls or fails.", resource),
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func WaitForSnapshotSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Wait for the source to be `running` and to commit its initial snapshot before completing, for up to the `create` timeout. The apply fails with the error reported by the source if it stalls or fails.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// statementDiffSuppress ignores differences between statements that only
+// differ in formatting, casing or the qualification of object names, such as
+// between a statement and the definition Materialize stores for it.
+func statementDiffSuppress(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return statementsEquivalent(oldValue, newValue, d.Get("database_name").(string))
+}
+
+// statementChanged reports semantic changes to the statement when customizing
+// a diff, as HasChange does not take statementDiffSuppress into account
+func statementChanged(d *schema.ResourceDiff) bool {
+	if !d.HasChange("statement") {
+		return false
+	}
+
+	o, n := d.GetChange("statement")
+	return !statementsEquivalent(o.(string), n.(string), d.Get("database_name").(string))
+}
+
+func statementsEquivalent(a, b, databaseName string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	return materialize.NormalizeStatement(a, databaseName) == materialize.NormalizeStatement(b, databaseName)
+}
+
+// setStatement populates the statement from the stored definition on import.
+// Otherwise the statement is kept as written, as the definition may not be
+// normalized to the same form, and changes made outside of Terraform are only
+// logged.
+func setStatement(d *schema.ResourceData, definition string) error {
+	if definition == "" {
+		return nil
+	}
+
+	statement := d.Get("statement").(string)
+	if statement == "" {
+		return d.Set("statement", definition)
+	}
+
+	if !statementsEquivalent(statement, definition, d.Get("database_name").(string)) {
+		log.Printf("[WARN] the definition of %s differs from its statement, it may have been changed outside of Terraform: %s", d.Get("name").(string), definition)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementDiffSuppress(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{ID: "aws/us-east-1:u1", Attributes: map[string]string{
+		"id":            "aws/us-east-1:u1",
+		"name":          "view",
+		"schema_name":   "public",
+		"database_name": "materialize",
+		"statement":     `SELECT "id" FROM "materialize"."public"."t";`,
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "view",
+		"schema_name":   "public",
+		"database_name": "materialize",
+		"statement":     "select id\nfrom t",
+	})
+
+	diff, err := View().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.Nil(diff)
+
+	// Does not replace the materialized view with the default strategy
+	state.Attributes["replacement_strategy"] = "replace"
+	state.Attributes["wait_until_ready"] = "false"
+	diff, err = MaterializedView().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.Nil(diff)
+}
+
+func TestSetStatement(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		statement  string
+		definition string
+		expected   string
+	}{
+		// Formatting differences keep the statement as written
+		{"SELECT 1 FROM t", `SELECT 1 FROM "database"."public"."t";`, "SELECT 1 FROM t"},
+		// Differing definitions keep the statement as written
+		{"SELECT 1 FROM t", `SELECT 2 FROM "database"."public"."t";`, "SELECT 1 FROM t"},
+		// Imported objects take the definition
+		{"", `SELECT 1 FROM "database"."public"."t";`, `SELECT 1 FROM "database"."public"."t";`},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, View().Schema, map[string]interface{}{
+			"name":          "view",
+			"database_name": "database",
+			"statement":     c.statement,
+		})
+
+		r.NoError(setStatement(d, c.definition))
+		r.Equal(c.expected, d.Get("statement"))
+	}
+}
+
+func TestResourceMaterializedViewReadDrift(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, MaterializedView().Schema, map[string]interface{}{
+		"name":          "materialized_view",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"statement":     "SELECT 2 FROM 1",
+	})
+	d.SetId("u1")
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_materialized_views.id = 'u1'`
+		testhelpers.MockMaterializeViewScan(mock, pp)
+
+		if err := materializedViewRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// A differing definition is logged, the statement is kept as written
+		r.Equal("SELECT 2 FROM 1", d.Get("statement"))
+	})
+}
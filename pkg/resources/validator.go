@@ -1,11 +1,15 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
+	"sync"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
 )
 
 func validPrivileges(objType string) schema.SchemaValidateFunc {
@@ -36,3 +40,65 @@ func validPrivileges(objType string) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// Replica sizes read from mz_cluster_replica_sizes, cached per provider
+// connection so each plan queries the catalog once.
+var replicaSizeCache sync.Map
+
+func replicaSizes(conn *sqlx.DB) ([]string, error) {
+	if v, ok := replicaSizeCache.Load(conn); ok {
+		return v.([]string), nil
+	}
+
+	s, err := materialize.ListClusterReplicaSizes(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var sizes []string
+	for _, p := range s {
+		sizes = append(sizes, p.Size.String)
+	}
+
+	replicaSizeCache.Store(conn, sizes)
+	return sizes, nil
+}
+
+// validSize checks a planned size against the sizes available in the region.
+// Validation is skipped if the catalog cannot be read, leaving the error to
+// the statement that uses the size.
+func validSize(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("size") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	v := d.Get("size").(string)
+	if v == "" {
+		return nil
+	}
+
+	conn, ok := meta.(*sqlx.DB)
+	if !ok || conn == nil {
+		return nil
+	}
+
+	sizes, err := replicaSizes(conn)
+	if err != nil || len(sizes) == 0 {
+		log.Printf("[DEBUG] unable to read replica sizes, skipping size validation: %v", err)
+		return nil
+	}
+
+	for _, s := range sizes {
+		if strings.EqualFold(s, v) {
+			return nil
+		}
+	}
+
+	var f []string
+	for _, s := range sizes {
+		f = append(f, fmt.Sprintf(`'%s'`, s))
+	}
+	fs := strings.Join(f[:], ", ")
+
+	return fmt.Errorf("expected size to be one of (%v), got '%s'", fs, v)
+}
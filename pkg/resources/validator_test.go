@@ -1,9 +1,16 @@
 package resources
 
 import (
+	"context"
 	"testing"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 type testCase struct {
@@ -47,3 +54,20 @@ func TestValidPrivileges(t *testing.T) {
 		},
 	})
 }
+
+func TestValidSize(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		// Sizes are read once and cached for the connection
+		testhelpers.MockClusterReplicaSizeScan(mock)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "cluster", "size": "100cc"})
+		_, err := Cluster().Diff(context.TODO(), nil, config, db)
+		r.NoError(err)
+
+		config = terraform.NewResourceConfigRaw(map[string]interface{}{"name": "cluster", "size": "3xsmall"})
+		_, err = Cluster().Diff(context.TODO(), nil, config, db)
+		r.EqualError(err, "expected size to be one of ('25cc', '100cc'), got '3xsmall'")
+	})
+}
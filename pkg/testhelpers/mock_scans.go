@@ -62,12 +62,23 @@ func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_clusters.size,
 		mz_clusters.replication_factor,
 		mz_clusters.disk,
+		zones.availability_zones,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_clusters.privileges
 	FROM mz_clusters
 	JOIN mz_roles
 		ON mz_clusters.owner_id = mz_roles.id
+	LEFT JOIN \(
+		SELECT cluster_id, array_agg\(availability_zone\) AS availability_zones
+		FROM \(
+			SELECT DISTINCT cluster_id, availability_zone
+			FROM mz_cluster_replicas
+			WHERE availability_zone IS NOT NULL
+		\) replica_zones
+		GROUP BY cluster_id
+	\) zones
+		ON mz_clusters.id = zones.cluster_id
 	LEFT JOIN \(
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -76,8 +87,56 @@ func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_clusters.id = comments.id`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "managed", "size", "replication_factor", "disk", "comment", "owner_name", "privileges"}).
-		AddRow("u1", "cluster", true, "small", 2, true, "comment", "joe", defaultPrivilege)
+	ir := mock.NewRows([]string{"id", "name", "managed", "size", "replication_factor", "disk", "availability_zones", "comment", "owner_name", "privileges"}).
+		AddRow("u1", "cluster", true, "small", 2, true, "{use1-az1,use1-az2}", "comment", "joe", defaultPrivilege)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockCommentScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		comments.id,
+		comments.object_type,
+		comments.comment,
+		mz_columns.name AS column_name,
+		objects.name AS object_name,
+		objects.schema_name,
+		objects.database_name,
+		objects.cluster_name
+	FROM mz_internal.mz_comments comments
+	JOIN \(
+		SELECT mz_objects.id, mz_objects.type AS object_type, mz_objects.name, mz_schemas.name AS schema_name, mz_databases.name AS database_name, NULL AS cluster_name
+		FROM mz_objects
+		JOIN mz_schemas
+			ON mz_objects.schema_id = mz_schemas.id
+		LEFT JOIN mz_databases
+			ON mz_schemas.database_id = mz_databases.id
+		UNION ALL
+		SELECT mz_schemas.id, 'schema', mz_schemas.name, NULL, mz_databases.name, NULL
+		FROM mz_schemas
+		LEFT JOIN mz_databases
+			ON mz_schemas.database_id = mz_databases.id
+		UNION ALL
+		SELECT id, 'database', name, NULL, NULL, NULL FROM mz_databases
+		UNION ALL
+		SELECT id, 'cluster', name, NULL, NULL, NULL FROM mz_clusters
+		UNION ALL
+		SELECT mz_cluster_replicas.id, 'cluster-replica', mz_cluster_replicas.name, NULL, NULL, mz_clusters.name
+		FROM mz_cluster_replicas
+		JOIN mz_clusters
+			ON mz_cluster_replicas.cluster_id = mz_clusters.id
+		UNION ALL
+		SELECT id, 'role', name, NULL, NULL, NULL FROM mz_roles
+	\) objects
+		ON comments.id = objects.id
+		AND comments.object_type = objects.object_type
+	LEFT JOIN mz_columns
+		ON comments.id = mz_columns.id
+		AND comments.object_sub_id = mz_columns.position`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "object_type", "comment", "column_name", "object_name", "schema_name", "database_name", "cluster_name"}).
+		AddRow("u1", "table", "comment", nil, "table", "schema", "database", nil)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -113,6 +172,10 @@ func MockConnectionScan(mock sqlmock.Sqlmock, predicate string) {
 }
 
 func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
+	MockConnectionAwsPrivatelinkStatusScan(mock, predicate, "available")
+}
+
+func MockConnectionAwsPrivatelinkStatusScan(mock sqlmock.Sqlmock, predicate, status string) {
 	b := `
 	SELECT
 		mz_connections.id,
@@ -120,6 +183,7 @@ func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
 		mz_aws_privatelink_connections.principal,
+		mz_aws_privatelink_connection_statuses.status,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name
 	FROM mz_connections
@@ -129,6 +193,8 @@ func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_schemas.database_id = mz_databases.id
 	LEFT JOIN mz_aws_privatelink_connections
 		ON mz_connections.id = mz_aws_privatelink_connections.id
+	LEFT JOIN mz_internal.mz_aws_privatelink_connection_statuses
+		ON mz_connections.id = mz_aws_privatelink_connection_statuses.id
 	JOIN mz_roles
 		ON mz_connections.owner_id = mz_roles.id
 	LEFT JOIN \(
@@ -139,8 +205,8 @@ func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_connections.id = comments.id`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "principal"}).
-		AddRow("u1", "connection", "schema", "database", "principal")
+	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "principal", "status"}).
+		AddRow("u1", "connection", "schema", "database", "principal", status)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -286,6 +352,11 @@ func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
 }
 
 func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
+	MockMaterializeViewIdScan(mock, predicate, "u1")
+}
+
+// MockMaterializeViewIdScan returns a materialized view with the given id.
+func MockMaterializeViewIdScan(mock sqlmock.Sqlmock, predicate, id string) {
 	b := `
 	SELECT
 		mz_materialized_views.id,
@@ -293,6 +364,7 @@ func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
 		mz_clusters.name AS cluster_name,
+		mz_materialized_views.definition,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_materialized_views.privileges
@@ -314,8 +386,8 @@ func MockMaterializeViewScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_materialized_views.id = comments.id`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "materialized_view_name", "schema_name", "database_name", "cluster_name", "owner_name", "privileges"}).
-		AddRow("u1", "view", "schema", "database", "cluster", "joe", defaultPrivilege)
+	ir := mock.NewRows([]string{"id", "materialized_view_name", "schema_name", "database_name", "cluster_name", "definition", "owner_name", "privileges"}).
+		AddRow(id, "view", "schema", "database", "cluster", `SELECT 1 FROM "1";`, "joe", defaultPrivilege)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -332,6 +404,8 @@ func MockRoleScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_roles.id,
 		mz_roles.name AS role_name,
 		mz_roles.inherit,
+		mz_roles.rolcanlogin AS login,
+		mz_roles.rolsuper AS superuser,
 		comments.comment AS comment
 	FROM mz_roles
 	LEFT JOIN \(
@@ -342,8 +416,8 @@ func MockRoleScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_roles.id = comments.id`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "role_name", "inherit"}).
-		AddRow("u1", "joe", true)
+	ir := mock.NewRows([]string{"id", "role_name", "inherit", "login", "superuser"}).
+		AddRow("u1", "joe", true, true, false)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -361,6 +435,23 @@ func MockRoleGrantScan(mock sqlmock.Sqlmock) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockRoleParameterScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_role_parameters.role_id,
+		mz_roles.name AS role_name,
+		mz_role_parameters.parameter_name,
+		mz_role_parameters.parameter_value
+	FROM mz_role_parameters
+	JOIN mz_roles
+		ON mz_role_parameters.role_id = mz_roles.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"role_id", "role_name", "parameter_name", "parameter_value"}).
+		AddRow("u1", "role", "cluster", "quickstart")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockSchemaScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -417,8 +508,7 @@ func MockSecretScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
-func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
-	b := `
+var sinkScanQuery = `
 	SELECT
 		mz_sinks.id,
 		mz_sinks.name,
@@ -429,8 +519,11 @@ func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_sinks.envelope_type,
 		mz_connections.name as connection_name,
 		mz_clusters.name as cluster_name,
+		mz_kafka_sinks.topic,
 		comments.comment AS comment,
-		mz_roles.name AS owner_name
+		mz_roles.name AS owner_name,
+		mz_sink_statuses.status,
+		mz_sink_statuses.error
 	FROM mz_sinks
 	JOIN mz_schemas
 		ON mz_sinks.schema_id = mz_schemas.id
@@ -440,6 +533,8 @@ func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_sinks.connection_id = mz_connections.id
 	LEFT JOIN mz_clusters
 		ON mz_sinks.cluster_id = mz_clusters.id
+	LEFT JOIN mz_kafka_sinks
+		ON mz_sinks.id = mz_kafka_sinks.id
 	JOIN mz_roles
 		ON mz_sinks.owner_id = mz_roles.id
 	LEFT JOIN \(
@@ -447,16 +542,23 @@ func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'sink'
 	\) comments
-		ON mz_sinks.id = comments.id`
+		ON mz_sinks.id = comments.id
+	LEFT JOIN mz_internal.mz_sink_statuses
+		ON mz_sinks.id = mz_sink_statuses.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "sink_type", "size", "envelope_type", "connection_name", "cluster_name", "owner_name"}).
-		AddRow("u1", "sink", "schema", "database", "kafka", "small", "JSON", "conn", "cluster", "joe")
+func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
+	MockSinkStatusScan(mock, predicate, "running", "")
+}
+
+// MockSinkStatusScan returns a sink with the given status and error.
+func MockSinkStatusScan(mock sqlmock.Sqlmock, predicate, status, err string) {
+	q := mockQueryBuilder(sinkScanQuery, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "sink_type", "size", "envelope_type", "connection_name", "cluster_name", "topic", "owner_name", "status", "error"}).
+		AddRow("u1", "sink", "schema", "database", "kafka", "small", "JSON", "conn", "cluster", "topic", "joe", status, err)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
-func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
-	b := `
+var sourceScanQuery = `
 	SELECT
 		mz_sources.id,
 		mz_sources.name,
@@ -469,7 +571,9 @@ func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_clusters.name as cluster_name,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
-		mz_sources.privileges
+		mz_sources.privileges,
+		mz_source_statuses.status,
+		mz_source_statuses.error
 	FROM mz_sources
 	JOIN mz_schemas
 		ON mz_sources.schema_id = mz_schemas.id
@@ -486,11 +590,78 @@ func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
 		FROM mz_internal.mz_comments
 		WHERE object_type = 'source'
 	\) comments
-		ON mz_sources.id = comments.id`
+		ON mz_sources.id = comments.id
+	LEFT JOIN mz_internal.mz_source_statuses
+		ON mz_sources.id = mz_source_statuses.id`
+
+func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
+	MockSourceStatusScan(mock, predicate, "running", "")
+}
+
+// MockSourceStatusScan returns a source with the given status and error.
+func MockSourceStatusScan(mock sqlmock.Sqlmock, predicate, status, err string) {
+	q := mockQueryBuilder(sourceScanQuery, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_type", "size", "envelope_type", "connection_name", "cluster_name", "owner_name", "privileges", "status", "error"}).
+		AddRow("u1", "source", "schema", "database", "kafka", "small", "BYTES", "conn", "cluster", "joe", defaultPrivilege, status, err)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockSourceSnapshotScan(mock sqlmock.Sqlmock, predicate string, committed bool) {
+	b := `
+	SELECT bool_and\(mz_source_statistics.snapshot_committed\) AS snapshot_committed
+	FROM mz_internal.mz_source_statistics`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_type", "size", "envelope_type", "connection_name", "cluster_name", "owner_name", "privileges"}).
-		AddRow("u1", "source", "schema", "database", "kafka", "small", "BYTES", "conn", "cluster", "joe", defaultPrivilege)
+	ir := mock.NewRows([]string{"snapshot_committed"}).AddRow(committed)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockSourceTableScan(mock sqlmock.Sqlmock, predicate, sourceType string) {
+	b := `
+	SELECT
+		mz_tables.id,
+		mz_tables.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_sources.name AS source_name,
+		source_schemas.name AS source_schema_name,
+		source_databases.name AS source_database_name,
+		mz_sources.type AS source_type,
+		COALESCE\(mz_postgres_source_tables.table_name, mz_mysql_source_tables.table_name, mz_kafka_source_tables.topic\) AS upstream_name,
+		COALESCE\(mz_postgres_source_tables.schema_name, mz_mysql_source_tables.schema_name\) AS upstream_schema_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name,
+		mz_tables.privileges
+	FROM mz_tables
+	JOIN mz_schemas
+		ON mz_tables.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_sources
+		ON mz_tables.source_id = mz_sources.id
+	JOIN mz_schemas AS source_schemas
+		ON mz_sources.schema_id = source_schemas.id
+	JOIN mz_databases AS source_databases
+		ON source_schemas.database_id = source_databases.id
+	LEFT JOIN mz_internal.mz_postgres_source_tables
+		ON mz_tables.id = mz_postgres_source_tables.id
+	LEFT JOIN mz_internal.mz_mysql_source_tables
+		ON mz_tables.id = mz_mysql_source_tables.id
+	LEFT JOIN mz_internal.mz_kafka_source_tables
+		ON mz_tables.id = mz_kafka_source_tables.id
+	JOIN mz_roles
+		ON mz_tables.owner_id = mz_roles.id
+	LEFT JOIN \(
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'table'
+		AND object_sub_id IS NULL
+	\) comments
+		ON mz_tables.id = comments.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_name", "source_schema_name", "source_database_name", "source_type", "upstream_name", "upstream_schema_name", "comment", "owner_name", "privileges"}).
+		AddRow("u1", "table", "schema", "database", "source", "public", "materialize", sourceType, "upstream_table", "upstream_schema", "comment", "materialize", defaultPrivilege)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -517,6 +688,21 @@ func MockSubsourceScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockSystemParameterScan(mock sqlmock.Sqlmock, parameterName string) {
+	q := fmt.Sprintf(`SHOW %s;`, parameterName)
+	ir := mock.NewRows([]string{parameterName}).
+		AddRow("100")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockSystemParametersScan(mock sqlmock.Sqlmock) {
+	q := `SHOW ALL;`
+	ir := mock.NewRows([]string{"name", "setting", "description"}).
+		AddRow("max_tables", "100", "The maximum number of tables in the region, across all schemas (Materialize).").
+		AddRow("max_sources", "100", "The maximum number of sources in the region, across all schemas (Materialize).")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockTableColumnScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -542,6 +728,41 @@ func MockTableColumnScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+// Columns are given as name and type pairs in position order
+func MockRelationColumnScan(mock sqlmock.Sqlmock, predicate string, columns [][]string) {
+	b := `
+	SELECT
+		mz_columns.id,
+		mz_columns.name,
+		mz_columns.position,
+		mz_columns.nullable,
+		mz_columns.type
+	FROM mz_columns
+	JOIN mz_objects
+		ON mz_columns.id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`
+
+	q := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
+	ir := mock.NewRows([]string{"id", "name", "position", "nullable", "type"})
+	for i, c := range columns {
+		ir.AddRow("u1", c[0], fmt.Sprint(i+1), "false", c[1])
+	}
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+// Columns are given as name and type pairs in position order
+func MockQueryColumnScan(mock sqlmock.Sqlmock, selectStmt string, columns [][]string) {
+	var c []*sqlmock.Column
+	for _, col := range columns {
+		c = append(c, mock.NewColumn(col[0]).OfType(col[1], ""))
+	}
+	q := fmt.Sprintf(`SELECT \* FROM \(%s\) LIMIT 0;`, selectStmt)
+	mock.ExpectQuery(q).WillReturnRows(mock.NewRowsWithColumnDefinition(c...))
+}
+
 func MockSystemGrantScan(mock sqlmock.Sqlmock) {
 	q := `SELECT privileges FROM mz_system_privileges`
 	ir := mock.NewRows([]string{"privileges"}).
@@ -618,6 +839,7 @@ func MockViewScan(mock sqlmock.Sqlmock, predicate string) {
 		mz_views.name,
 		mz_schemas.name AS schema_name,
 		mz_databases.name AS database_name,
+		mz_views.definition,
 		comments.comment AS comment,
 		mz_roles.name AS owner_name,
 		mz_views.privileges
@@ -637,7 +859,76 @@ func MockViewScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_views.id = comments.id`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := sqlmock.NewRows([]string{"id", "name", "schema_name", "database_name", "owner_name", "privileges"}).
-		AddRow("u1", "view", "schema", "database", "joe", defaultPrivilege)
+	ir := sqlmock.NewRows([]string{"id", "name", "schema_name", "database_name", "definition", "owner_name", "privileges"}).
+		AddRow("u1", "view", "schema", "database", `SELECT 1 FROM "1";`, "joe", defaultPrivilege)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockClusterReplicaSizeScan(mock sqlmock.Sqlmock) {
+	b := `
+	SELECT
+		mz_cluster_replica_sizes.size,
+		mz_cluster_replica_sizes.processes,
+		mz_cluster_replica_sizes.workers,
+		mz_cluster_replica_sizes.cpu_nano_cores,
+		mz_cluster_replica_sizes.memory_bytes,
+		mz_cluster_replica_sizes.disk_bytes,
+		mz_cluster_replica_sizes.credits_per_hour
+	FROM mz_cluster_replica_sizes`
+
+	q := mockQueryBuilder(b, "", "ORDER BY mz_cluster_replica_sizes.credits_per_hour, mz_cluster_replica_sizes.size")
+	ir := mock.NewRows([]string{"size", "processes", "workers", "cpu_nano_cores", "memory_bytes", "disk_bytes", "credits_per_hour"}).
+		AddRow("25cc", 1, 1, 500000000, 4026531840, 7516192768, 0.25).
+		AddRow("100cc", 1, 2, 2000000000, 16106127360, 30064771072, 1)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+// Dependents are given as name and type pairs
+func MockDependentScan(mock sqlmock.Sqlmock, predicate string, dependents [][]string) {
+	b := `
+	SELECT
+		mz_object_dependencies.object_id,
+		mz_object_dependencies.referenced_object_id,
+		mz_objects.name AS object_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_objects.type
+	FROM mz_internal.mz_object_dependencies
+	JOIN mz_objects
+		ON mz_object_dependencies.object_id = mz_objects.id
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"})
+	for i, d := range dependents {
+		ir.AddRow(fmt.Sprintf("u%d", i+2), "u1", d[0], "schema", "database", d[1])
+	}
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+// MockHydrationStatusScan returns the object hydrated on replica r1 and with
+// the given hydration status on replica r2.
+func MockHydrationStatusScan(mock sqlmock.Sqlmock, predicate string, hydrated bool) {
+	b := `
+	SELECT
+		mz_hydration_statuses.object_id,
+		mz_objects.name AS object_name,
+		mz_hydration_statuses.replica_id,
+		mz_cluster_replicas.name AS replica_name,
+		mz_cluster_replicas.cluster_id,
+		mz_hydration_statuses.hydrated
+	FROM mz_internal.mz_hydration_statuses
+	JOIN mz_objects
+		ON mz_hydration_statuses.object_id = mz_objects.id
+	JOIN mz_cluster_replicas
+		ON mz_hydration_statuses.replica_id = mz_cluster_replicas.id`
+
+	q := mockQueryBuilder(b, predicate, "ORDER BY mz_cluster_replicas.name, mz_objects.name")
+	ir := mock.NewRows([]string{"object_id", "object_name", "replica_id", "replica_name", "cluster_id", "hydrated"}).
+		AddRow("u1", "object", "u1", "r1", "u1", true).
+		AddRow("u1", "object", "u2", "r2", "u1", hydrated)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
@@ -8,7 +8,11 @@ import (
 
 var Region string
 
+// Host of the Materialize region, used to build URLs for objects such as webhook sources
+var Host string
+
 func SetRegionFromHostname(host string) error {
+	Host = host
 	defaultRegion := "aws/us-east-1"
 	if host == "localhost" || host == "materialize" || host == "materialized" || host == "127.0.0.1" {
 		Region = defaultRegion